@@ -0,0 +1,113 @@
+package treesitter
+
+import (
+	"slices"
+	"unicode/utf8"
+
+	"github.com/cptaffe/acme-styles/layer"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// partners maps each bracket to the one that closes or opens it.
+var partners = map[byte]byte{
+	'(': ')', ')': '(',
+	'[': ']', ']': '[',
+	'{': '}', '}': '{',
+}
+
+// matchBracket finds the bracket at byte offset off of src, or failing
+// that the one just before it (where dot sits after typing a bracket), and
+// returns the byte offsets of it and its partner.  Pairing comes from the
+// syntax tree, so brackets in strings and comments are never paired and
+// unbalanced text pairs only what the grammar understood.  tree, if
+// non-nil, must have been parsed from src; otherwise src is parsed when
+// there is a bracket to pair.
+func matchBracket(lang *Language, tree *tree_sitter.Tree, src []byte, off int) (a, b int, ok bool) {
+	if lang == nil {
+		return 0, 0, false
+	}
+	for _, c := range []int{off, off - 1} {
+		if c < 0 || c >= len(src) {
+			continue
+		}
+		want, isBracket := partners[src[c]]
+		if !isBracket {
+			continue
+		}
+		if tree == nil {
+			tree = parse(lang, src, nil)
+			defer tree.Close()
+		}
+		node := tree.RootNode().DescendantForByteRange(uint(c), uint(c+1))
+		if node == nil || node.IsNamed() || node.Kind() != string(src[c]) {
+			continue // part of a string, comment or other token
+		}
+		parent := node.Parent()
+		if parent == nil {
+			continue
+		}
+		// Openers pair with their parent's last matching child, closers
+		// with its first.
+		n := parent.ChildCount()
+		for i := uint(0); i < n; i++ {
+			j := i
+			if want == ')' || want == ']' || want == '}' {
+				j = n - 1 - i
+			}
+			sib := parent.Child(j)
+			if sib != nil && !sib.IsNamed() && sib.Kind() == string(want) {
+				return c, int(sib.StartByte()), true
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// bracketEntries returns the bracket.match entries, in rune offsets, for
+// the bracket pair at byte offsets a and b of src.
+func bracketEntries(src []byte, a, b int) []layer.Entry {
+	if a > b {
+		a, b = b, a
+	}
+	name := canonicalTable[lookupCaptureIdx("bracket.match")]
+	ra := utf8.RuneCount(src[:a])
+	rb := ra + utf8.RuneCount(src[a:b])
+	return []layer.Entry{{Name: name, Start: ra, End: ra + 1}, {Name: name, Start: rb, End: rb + 1}}
+}
+
+// overlay returns base with extra laid on top: base entries are trimmed or
+// split wherever an extra entry covers them.  Both must be sorted and
+// non-overlapping, and so is the result.
+func overlay(base, extra []layer.Entry) []layer.Entry {
+	if len(extra) == 0 {
+		return base
+	}
+	out := make([]layer.Entry, 0, len(base)+2*len(extra))
+	for _, e := range base {
+		for _, x := range extra {
+			if x.End <= e.Start || x.Start >= e.End {
+				continue
+			}
+			if x.Start > e.Start {
+				out = append(out, layer.Entry{Name: e.Name, Start: e.Start, End: x.Start})
+			}
+			e.Start = x.End
+		}
+		if e.Start < e.End {
+			out = append(out, e)
+		}
+	}
+	out = append(out, extra...)
+	slices.SortFunc(out, func(x, y layer.Entry) int { return x.Start - y.Start })
+	return out
+}
+
+// runeToByte converts rune offset q in src to a byte offset.
+func runeToByte(src []byte, q int) int {
+	off := 0
+	for ; q > 0 && off < len(src); q-- {
+		_, size := utf8.DecodeRune(src[off:])
+		off += size
+	}
+	return off
+}
@@ -0,0 +1,110 @@
+package treesitter
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/cptaffe/acme-styles/layer"
+)
+
+func TestMatchBracket(t *testing.T) {
+	src := []byte("package a\n\nfunc f() { g(a[1], \"(\") }\n")
+	lang := langByID("go")
+	// find returns the offset of the nth (from 0) occurrence of s.
+	find := func(s string, nth int) int {
+		n := 0
+		for i := range src {
+			if string(src[i:i+len(s)]) == s {
+				if n == nth {
+					return i
+				}
+				n++
+			}
+		}
+		t.Fatalf("%q #%d not in src", s, nth)
+		return 0
+	}
+	open, close := find("{", 0), find("}", 0)
+	callOpen, callClose := find("(", 1), find(")", 1)
+	strParen := find("(", 2)
+
+	for _, c := range []struct {
+		name string
+		off  int
+		a, b int
+		ok   bool
+	}{
+		{"on opener", open, open, close, true},
+		{"after opener", open + 1, open, close, true},
+		{"on closer", close, close, open, true},
+		{"call", callOpen, callOpen, callClose, true},
+		{"in string", strParen, 0, 0, false},
+		{"not a bracket", find("g", 0), 0, 0, false},
+	} {
+		a, b, ok := matchBracket(lang, nil, src, c.off)
+		if ok != c.ok || ok && (a != c.a || b != c.b) {
+			t.Errorf("%s: matchBracket(%d) = %d, %d, %v; want %d, %d, %v", c.name, c.off, a, b, ok, c.a, c.b, c.ok)
+		}
+	}
+}
+
+func TestOverlay(t *testing.T) {
+	base := []layer.Entry{{Name: "k", Start: 0, End: 4}, {Name: "o", Start: 5, End: 6}}
+	extra := []layer.Entry{{Name: "b", Start: 2, End: 3}, {Name: "b", Start: 5, End: 6}}
+	want := []layer.Entry{
+		{Name: "k", Start: 0, End: 2},
+		{Name: "b", Start: 2, End: 3},
+		{Name: "k", Start: 3, End: 4},
+		{Name: "b", Start: 5, End: 6},
+	}
+	if got := overlay(base, extra); !reflect.DeepEqual(got, want) {
+		t.Errorf("overlay = %+v, want %+v", got, want)
+	}
+}
+
+func TestMatchDot(t *testing.T) {
+	ctx := context.Background()
+	w := &fakeWin{body: "package a\n\nvar x = f(1)\n"}
+	sl := &fakeLayer{}
+	s := &session{name: "/src/a.go", lang: langByID("go"), sl: sl, w: w,
+		opts: Options{MatchBrackets: 1}, dot: -1}
+	if err := doHighlight(ctx, s); err != nil {
+		t.Fatal(err)
+	}
+	base := sl.entries
+
+	w.dot = 20 // on "("
+	if err := s.matchDot(); err != nil {
+		t.Fatal(err)
+	}
+	if want := overlay(base, bracketEntries([]byte(w.body), 20, 22)); !reflect.DeepEqual(sl.entries, want) {
+		t.Errorf("entries with dot on ( = %+v, want %+v", sl.entries, want)
+	}
+
+	w.dot = 12 // on "var"
+	if err := s.matchDot(); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(sl.entries, base) {
+		t.Errorf("entries with dot off brackets = %+v, want %+v", sl.entries, base)
+	}
+}
+
+// TestMatchDotOversize checks that bodies over max_bytes are not parsed
+// for bracket pairs.
+func TestMatchDotOversize(t *testing.T) {
+	w := &fakeWin{body: "package a\n\nvar x = f(1)\n", dot: 20}
+	sl := &fakeLayer{}
+	s := &session{name: "/src/a.go", lang: langByID("go"), sl: sl, w: w,
+		opts: Options{MatchBrackets: 1, MaxBytes: map[string]int{"go": 16}, LiteOversize: true}, dot: -1}
+	if err := doHighlight(context.Background(), s); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.matchDot(); err != nil {
+		t.Fatal(err)
+	}
+	if sl.applies != 1 || sl.entries != nil {
+		t.Errorf("oversized body: applies = %d, entries = %v; want only the clearing apply", sl.applies, sl.entries)
+	}
+}
@@ -0,0 +1,64 @@
+package treesitter
+
+import (
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/cptaffe/acme-styles/layer"
+)
+
+// reopenCache remembers the last highlight of recently closed windows, keyed
+// by file name, so a file that is closed and reopened within
+// Options.ReopenCache can skip its initial parse.
+var reopenCache = entryCache{m: make(map[string]cachedHighlight)}
+
+// entryCache is a small TTL cache of highlight results.  Safe for
+// concurrent use.
+type entryCache struct {
+	mu sync.Mutex
+	m  map[string]cachedHighlight
+}
+
+type cachedHighlight struct {
+	lang    *Language
+	sum     uint64 // bodySum of the highlighted body
+	entries []layer.Entry
+	stored  time.Time
+}
+
+// put records h for name, dropping any entries older than ttl.
+func (c *entryCache) put(name string, h cachedHighlight, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k, v := range c.m {
+		if h.stored.Sub(v.stored) > ttl {
+			delete(c.m, k)
+		}
+	}
+	c.m[name] = h
+}
+
+// take removes and returns the entries cached for name if they were stored
+// within ttl of now and were computed with lang from a body whose sum
+// matches.
+func (c *entryCache) take(name string, lang *Language, sum uint64, ttl time.Duration, now time.Time) ([]layer.Entry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	h, ok := c.m[name]
+	if !ok {
+		return nil, false
+	}
+	delete(c.m, name)
+	if h.lang != lang || h.sum != sum || now.Sub(h.stored) > ttl {
+		return nil, false
+	}
+	return h.entries, true
+}
+
+// bodySum returns a 64-bit FNV-1a hash of body.
+func bodySum(body []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(body)
+	return h.Sum64()
+}
@@ -0,0 +1,41 @@
+package treesitter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cptaffe/acme-styles/layer"
+)
+
+func TestEntryCache(t *testing.T) {
+	goLang, cLang := langByID("go"), langByID("c")
+	entries := []layer.Entry{{Name: "k", Start: 0, End: 7}}
+	now := time.Now()
+	ttl := 5 * time.Second
+	sum := bodySum([]byte("package main\n"))
+
+	cases := []struct {
+		name string
+		lang *Language
+		sum  uint64
+		at   time.Time
+		hit  bool
+	}{
+		{"hit", goLang, sum, now.Add(time.Second), true},
+		{"expired", goLang, sum, now.Add(6 * time.Second), false},
+		{"body changed", goLang, bodySum([]byte("package foo\n")), now, false},
+		{"language changed", cLang, sum, now, false},
+	}
+	for _, c := range cases {
+		var ec entryCache
+		ec.m = make(map[string]cachedHighlight)
+		ec.put("/tmp/x.go", cachedHighlight{lang: goLang, sum: sum, entries: entries, stored: now}, ttl)
+		_, hit := ec.take("/tmp/x.go", c.lang, c.sum, ttl, c.at)
+		if hit != c.hit {
+			t.Errorf("%s: hit = %v, want %v", c.name, hit, c.hit)
+		}
+		if _, again := ec.take("/tmp/x.go", goLang, sum, ttl, now); again {
+			t.Errorf("%s: entry still cached after take", c.name)
+		}
+	}
+}
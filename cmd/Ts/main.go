@@ -0,0 +1,58 @@
+// Ts sets the language acme-treesitter highlights an acme window with,
+// overriding filename and shebang detection.  Run it from the window's tag:
+//
+//	Ts python
+//	Ts off
+//
+// "off" stops highlighting the window.  The choice lasts until the window
+// is closed.  Ts finds the window through $winid and $samfile, which acme
+// sets for commands it runs, and asks acme-treesitter over its control
+// socket.
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	ts "github.com/cptaffe/acme-treesitter"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, "usage: Ts language|off")
+		os.Exit(2)
+	}
+	if err := run(os.Args[1]); err != nil {
+		fmt.Fprintf(os.Stderr, "Ts: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(lang string) error {
+	id, err := strconv.Atoi(os.Getenv("winid"))
+	if err != nil {
+		return fmt.Errorf("$winid not set; run Ts from an acme window")
+	}
+	r := ts.LangRequest{ID: id, Language: lang, Name: os.Getenv("samfile")}
+
+	c, err := net.Dial("unix", ts.ControlSocket())
+	if err != nil {
+		return fmt.Errorf("is acme-treesitter running? %w", err)
+	}
+	defer c.Close()
+	if _, err := fmt.Fprintf(c, "%s\n", r); err != nil {
+		return err
+	}
+	reply, err := bufio.NewReader(c).ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("read reply: %w", err)
+	}
+	if reply = strings.TrimSuffix(reply, "\n"); reply != "ok" {
+		return fmt.Errorf("%s", reply)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+
+	ts "github.com/cptaffe/acme-treesitter"
+	"github.com/cptaffe/acme-treesitter/logger"
+	"go.uber.org/zap"
+)
+
+// serveControl answers Ts requests on the unix socket at path until ctx is
+// cancelled.  Each connection carries one LangRequest line and gets back
+// "ok" or an error message.
+func serveControl(ctx context.Context, path string, t *tracker) error {
+	os.Remove(path) // left behind by a previous run
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return err
+	}
+	context.AfterFunc(ctx, func() { ln.Close() })
+	go func() {
+		defer os.Remove(path)
+		for {
+			c, err := ln.Accept()
+			if err != nil {
+				if !errors.Is(err, net.ErrClosed) {
+					logger.L(ctx).Warn("control socket", zap.Error(err))
+				}
+				return
+			}
+			go handleControl(c, t)
+		}
+	}()
+	return nil
+}
+
+func handleControl(c net.Conn, t *tracker) {
+	defer c.Close()
+	line, err := bufio.NewReader(c).ReadString('\n')
+	if err != nil {
+		return
+	}
+	r, err := ts.ParseLangRequest(line[:len(line)-1])
+	if err == nil {
+		err = t.setLanguage(r.ID, r.Name, r.Language)
+	}
+	if err != nil {
+		fmt.Fprintf(c, "%v\n", err)
+		return
+	}
+	fmt.Fprintln(c, "ok")
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	ts "github.com/cptaffe/acme-treesitter"
+	"github.com/cptaffe/acme-treesitter/config"
+)
+
+// writeHTML writes src, highlighted as language id, to w as an HTML
+// fragment: a <pre> with a <span> per highlight entry.  Spans are colored
+// inline from colors, which maps capture stems ("comment", "string") to
+// CSS colors, so the fragment survives pasting where stylesheets are
+// stripped.  With no colors the spans carry classes instead
+// ("ts-comment", "ts-function") for a stylesheet to color.
+func writeHTML(w io.Writer, id string, src []byte, colors map[string]string) error {
+	entries, err := ts.Highlight(id, src, ts.CaptureClasses())
+	if err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, `<pre class="ts ts-%s">`, html.EscapeString(id))
+	off, q := 0, 0 // byte and rune offsets of the text written so far
+	advance := func(to int) string {
+		start := off
+		for ; q < to && off < len(src); q++ {
+			_, size := utf8.DecodeRune(src[off:])
+			off += size
+		}
+		return html.EscapeString(string(src[start:off]))
+	}
+	for _, e := range entries {
+		bw.WriteString(advance(e.Start))
+		text := advance(e.End)
+		if c, ok := colors[e.Name]; ok {
+			fmt.Fprintf(bw, `<span style="color:%s">%s</span>`, html.EscapeString(c), text)
+		} else if colors == nil {
+			fmt.Fprintf(bw, `<span class="ts-%s">%s</span>`, strings.ReplaceAll(e.Name, ".", "-"), text)
+		} else {
+			bw.WriteString(text)
+		}
+	}
+	bw.WriteString(advance(utf8.RuneCount(src)))
+	bw.WriteString("</pre>\n")
+	return bw.Flush()
+}
+
+// readColors reads a color map for writeHTML: one capture stem and CSS
+// color per line, as in
+//
+//	comment #777777
+//	string  darkgreen
+//
+// Blank lines and lines starting with # are skipped.
+func readColors(file string) (map[string]string, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	colors := make(map[string]string)
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		f := strings.Fields(line)
+		if len(f) != 2 {
+			return nil, fmt.Errorf("%s:%d: want a capture stem and a color", file, i+1)
+		}
+		colors[f[0]] = f[1]
+	}
+	return colors, nil
+}
+
+// exportHTML writes file as HTML to w, detecting its language with the
+// handlers of config cfgPath, if set, or its shebang, and coloring it
+// from colorFile, if set.
+func exportHTML(w io.Writer, file, cfgPath, colorFile string) error {
+	src, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	var handlers []ts.Handler
+	if cfgPath != "" {
+		cfg, err := config.Load(cfgPath)
+		if err != nil {
+			return err
+		}
+		if handlers, err = ts.CompileHandlers(cfg); err != nil {
+			return err
+		}
+		if cfg.QueryDir != "" {
+			if err := ts.LoadQueryDir(cfg.QueryDir); err != nil {
+				return err
+			}
+		}
+	}
+	id := ts.LanguageFor(handlers, file, src)
+	if id == "" {
+		return fmt.Errorf("%s: no language detected", file)
+	}
+	var colors map[string]string
+	if colorFile != "" {
+		if colors, err = readColors(colorFile); err != nil {
+			return err
+		}
+	}
+	return writeHTML(w, id, src, colors)
+}
@@ -5,21 +5,43 @@
 //
 //   - allocates a compositor layer in acme-styles,
 //   - parses the body with tree-sitter and writes highlight entries, and
-//   - re-highlights after any body edit (debounced at 200 ms).
+//   - re-highlights after any body edit (debounced, 200 ms by default).
+//
+// Running "Ts <language>" in a window's tag forces that language on the
+// window, and "Ts off" stops highlighting it; see cmd/Ts.
+//
+// If the acme log is lost (acme restarted, say) it reconnects with backoff
+// and stops the sessions of windows that did not survive.
+//
+// SIGHUP reloads the config file; see reloadOnSignal.
 //
 // Usage:
 //
+//	acme-treesitter --init
 //	acme-treesitter --config ~/lib/acme-treesitter/config.yaml
+//	acme-treesitter --config - <config.yaml
+//	acme-treesitter --dumpquery go
+//	acme-treesitter --config config.yaml --replay session.log
+//	acme-treesitter --gclayers
+//	acme-treesitter --config config.yaml --html main.go >main.html
+//	acme-treesitter --config config.yaml --service systemd >~/.config/systemd/user/acme-treesitter.service
 package main
 
 import (
 	"context"
+	"errors"
 	"flag"
+	"fmt"
+	"io"
+	"io/fs"
 	"log"
+	"os"
 	"os/signal"
-	"sync"
+	"path/filepath"
+	"time"
 
 	"9fans.net/go/acme"
+	"github.com/cptaffe/acme-styles/layer"
 	ts "github.com/cptaffe/acme-treesitter"
 	"github.com/cptaffe/acme-treesitter/config"
 	"github.com/cptaffe/acme-treesitter/logger"
@@ -27,10 +49,66 @@ import (
 )
 
 func main() {
-	cfgPath := flag.String("config", "", "path to config.yaml (required)")
+	cfgPath := flag.String("config", "", "path to config.yaml, or - to read it from stdin (required)")
 	verbose := flag.Bool("v", false, "verbose logging")
+	tagTiming := flag.Bool("tagtiming", false, "show the last highlight duration in each window tag")
+	openOnly := flag.Bool("openonly", false, "highlight windows when opened but do not follow edits")
+	dumpQuery := flag.String("dumpquery", "", "print the capture names of a language's highlight query and exit")
+	keepLayers := flag.Bool("keeplayers", false, "debugging: leave each window's layer in place when its session ends")
+	gcLayers := flag.Bool("gclayers", false, "delete the layers of all open windows, as left by -keeplayers, and exit")
+	replay := flag.String("replay", "", "read acme log events from a recorded `file` instead of acme's log")
+	svc := flag.String("service", "", "print a service file running acme-treesitter with these flags for `manager` (systemd or launchd) and exit")
+	htmlFile := flag.String("html", "", "print `file` highlighted as an HTML fragment and exit; -config supplies the filename handlers")
+	colorFile := flag.String("colors", "", "with -html, color spans inline from this `file` of capture stem and CSS color pairs")
+	initCfg := flag.Bool("init", false, "write the default config to -config, or to ~/lib/acme-treesitter/config.yaml, unless it exists, and exit")
 	flag.Parse()
 
+	if *dumpQuery != "" {
+		if err := printQuery(os.Stdout, *dumpQuery); err != nil {
+			log.Fatalf("acme-treesitter: %v", err)
+		}
+		return
+	}
+	if *gcLayers {
+		n, err := deleteLayers()
+		if err != nil {
+			log.Fatalf("acme-treesitter: %v", err)
+		}
+		fmt.Printf("deleted %d layers\n", n)
+		return
+	}
+	if *initCfg {
+		path, err := writeDefaultConfig(*cfgPath)
+		if err != nil {
+			log.Fatalf("acme-treesitter: %v", err)
+		}
+		fmt.Printf("wrote %s\n", path)
+		return
+	}
+	if *htmlFile != "" {
+		if err := exportHTML(os.Stdout, *htmlFile, *cfgPath, *colorFile); err != nil {
+			log.Fatalf("acme-treesitter: %v", err)
+		}
+		return
+	}
+	if *svc != "" {
+		var args []string
+		flag.Visit(func(f *flag.Flag) {
+			switch f.Name {
+			case "v", "tagtiming", "openonly", "keeplayers":
+				args = append(args, "-"+f.Name+"="+f.Value.String())
+			}
+		})
+		s, err := newService(*cfgPath, args)
+		if err == nil {
+			err = writeService(os.Stdout, *svc, s)
+		}
+		if err != nil {
+			log.Fatalf("acme-treesitter: %v", err)
+		}
+		return
+	}
+
 	if *cfgPath == "" {
 		log.Fatal("acme-treesitter: --config flag is required")
 	}
@@ -48,83 +126,333 @@ func main() {
 	zap.ReplaceGlobals(l)
 	defer l.Sync() //nolint:errcheck
 
-	cfg, err := config.Load(*cfgPath)
+	cfg, handlers, opts, err := loadConfig(*cfgPath)
 	if err != nil {
 		l.Fatal("load config", zap.Error(err))
 	}
+	if cfg.StyleFile != "" {
+		// Harmless, but worth saying: editing the file changes nothing.
+		l.Info("style_file is ignored; the palette comes from acme-styles",
+			zap.String("style_file", cfg.StyleFile))
+	}
 
-	handlers, err := ts.CompileHandlers(cfg)
-	if err != nil {
-		l.Fatal("compile filename handlers", zap.Error(err))
+	if cfg.QueryDir != "" {
+		if err := ts.LoadQueryDir(cfg.QueryDir); err != nil {
+			l.Warn("query_dir: some queries were not loaded; using the built-in ones",
+				zap.String("query_dir", cfg.QueryDir), zap.Error(err))
+		}
 	}
-	l.Info("handlers compiled", zap.Int("count", len(handlers)))
 
+	l.Info("handlers compiled", zap.Int("count", len(handlers)))
+	setFlags := func(opts *ts.Options) {
+		opts.TagTiming = *tagTiming
+		opts.KeepLayers = *keepLayers
+		if *openOnly {
+			opts.OpenOnly = true
+		}
+	}
+	setFlags(&opts)
+	if !cfg.IsEnabled() {
+		l.Info("highlighting disabled by config; idling")
+	}
 
 	ctx, stop := signal.NotifyContext(context.Background(), shutdownSignals...)
 	defer stop()
 	ctx = logger.NewContext(ctx, l)
 
-	var wg sync.WaitGroup
+	t := newTracker(ctx, handlers, opts, cfg.IsEnabled())
+	go reloadOnSignal(ctx, *cfgPath, t, setFlags)
+	if err := serveControl(ctx, ts.ControlSocket(), t); err != nil {
+		l.Warn("control socket unavailable; Ts will not work", zap.Error(err))
+	}
+	if *replay != "" {
+		if err := replayFile(ctx, *replay, t); err != nil {
+			l.Error("replay", zap.Error(err))
+		}
+		<-ctx.Done() // leave the replayed sessions running until interrupted
+		t.wait()
+		return
+	}
+	b := ts.Backoff{Base: cfg.ReconnectBase, Cap: cfg.ReconnectCap}
+	for ctx.Err() == nil {
+		lr, unwatch, err := connect(ctx, t, &b)
+		if err != nil {
+			break // only fails once ctx is cancelled
+		}
+		l.Info("connected to acme log")
+		up := time.Now()
+		err = readLog(lr, t)
+		unwatch()
+		lr.Close()
+		if ctx.Err() != nil {
+			break
+		}
+		d := reconnectDelay(&b, time.Since(up))
+		l.Warn("acme log read failed; reconnecting", zap.Error(err), zap.Duration("in", d))
+		if sleep(ctx, d) != nil {
+			break
+		}
+	}
 
-	// active tracks which window IDs currently have a RunWindow goroutine.
-	// Guarded by activeMu.
-	var activeMu sync.Mutex
-	active := make(map[int]struct{})
+	t.wait()
+}
 
-	start := func(id int, name string) {
-		activeMu.Lock()
-		if _, ok := active[id]; ok {
-			activeMu.Unlock()
+// loadConfig reads the config at path and compiles the filename handlers
+// and window options it sets.
+func loadConfig(path string) (*config.Config, []ts.Handler, ts.Options, error) {
+	cfg, err := config.Load(path)
+	if err != nil {
+		return nil, nil, ts.Options{}, err
+	}
+	handlers, err := ts.CompileHandlers(cfg)
+	if err != nil {
+		return nil, nil, ts.Options{}, fmt.Errorf("compile filename handlers: %w", err)
+	}
+	opts := ts.NewOptions(cfg)
+	if opts.CaptureRules, err = ts.CompileCaptureRules(cfg); err != nil {
+		return nil, nil, ts.Options{}, fmt.Errorf("compile capture rules: %w", err)
+	}
+	return cfg, handlers, opts, nil
+}
+
+// reloadOnSignal reloads the config at path whenever one of
+// reloadSignals arrives, until ctx is cancelled.  Windows opened from
+// then on use the new handlers and options, setFlags having applied the
+// command-line flags to them; open windows are restarted only if their
+// file name now maps to another language, and windows the new handlers
+// match are started.  Turning enabled off stops every session, and
+// turning it back on starts them again.  An invalid config is logged and
+// the old one kept.  query_dir is read only at startup.
+func reloadOnSignal(ctx context.Context, path string, t *tracker, setFlags func(*ts.Options)) {
+	if len(reloadSignals) == 0 {
+		return
+	}
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, reloadSignals...)
+	defer signal.Stop(sig)
+	log := logger.L(ctx)
+	for {
+		select {
+		case <-ctx.Done():
 			return
+		case <-sig:
+		}
+		if path == "-" {
+			log.Warn("config was read from stdin; not reloading")
+			continue
+		}
+		cfg, handlers, opts, err := loadConfig(path)
+		if err != nil {
+			log.Error("reload config; keeping the old one", zap.Error(err))
+			continue
+		}
+		setFlags(&opts)
+		for id, name := range t.reconfigure(handlers, opts, cfg.IsEnabled()) {
+			t.start(id, name)
+		}
+		log.Info("config reloaded", zap.Int("handlers", len(handlers)))
+		if err := startWindows(t); err != nil {
+			log.Debug("not starting newly matched windows", zap.Error(err))
 		}
-		active[id] = struct{}{}
-		activeMu.Unlock()
-
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			defer func() {
-				activeMu.Lock()
-				delete(active, id)
-				activeMu.Unlock()
-			}()
-			ts.RunWindow(ctx, id, name, handlers)
-		}()
 	}
+}
 
+// startWindows starts sessions for the open acme windows that have none.
+func startWindows(t *tracker) error {
 	f, err := acme.Mount()
 	if err != nil {
-		l.Fatal("mount acme", zap.Error(err))
+		return fmt.Errorf("mount acme: %w", err)
 	}
+	wins, err := f.Windows()
+	if err != nil {
+		return fmt.Errorf("acme.Windows: %w", err)
+	}
+	for _, w := range wins {
+		t.start(w.ID, w.Name)
+	}
+	return nil
+}
 
+// writeDefaultConfig writes the default config to path, or to the
+// conventional path if path is empty, and returns where it went.  It will
+// not overwrite an existing file.
+func writeDefaultConfig(path string) (string, error) {
+	if path == "" {
+		var err error
+		if path, err = config.DefaultPath(); err != nil {
+			return "", err
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", err
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		if errors.Is(err, fs.ErrExist) {
+			return "", fmt.Errorf("%s already exists", path)
+		}
+		return "", err
+	}
+	if _, err := f.WriteString(config.Default); err != nil {
+		f.Close()
+		return "", err
+	}
+	return path, f.Close()
+}
+
+// printQuery writes the pattern count and capture names of language id's
+// highlight query to w, each capture with the palette name it is styled
+// with ("-" if none).
+func printQuery(w io.Writer, id string) error {
+	names, patterns, err := ts.QueryCaptures(id)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "%s: %d patterns, %d captures\n", id, patterns, len(names))
+	for _, n := range names {
+		style := ts.CaptureStyle(n)
+		if style == "" {
+			style = "-"
+		}
+		fmt.Fprintf(w, "@%s\t%s\n", n, style)
+	}
+	return nil
+}
+
+// deleteLayers removes this program's layer from every open acme window.
+// Run it with acme-treesitter stopped, after a -keeplayers session.
+func deleteLayers() (int, error) {
+	f, err := acme.Mount()
+	if err != nil {
+		return 0, fmt.Errorf("mount acme: %w", err)
+	}
 	wins, err := f.Windows()
 	if err != nil {
-		l.Fatal("acme.Windows", zap.Error(err))
+		return 0, fmt.Errorf("acme.Windows: %w", err)
 	}
+	n := 0
 	for _, w := range wins {
-		start(w.ID, w.Name)
+		sl, err := layer.Open(w.ID, ts.LayerName)
+		if err != nil {
+			continue // the window closed, or acme-styles does not know it
+		}
+		if err := sl.Delete(); err != nil {
+			return n, fmt.Errorf("window %d: %w", w.ID, err)
+		}
+		n++
 	}
+	return n, nil
+}
+
+// stableConnection is how long the acme log must stay up for its loss to
+// count as a blip rather than part of a run of failures.
+const stableConnection = time.Minute
 
-	lr, err := f.Log()
+// reconnectDelay returns how long to wait before reconnecting after a log
+// connection that lasted up.  A stable connection resets b, so the wait
+// is b's first, within its base; a short-lived one keeps escalating b, so
+// a flapping acme is not hammered.
+func reconnectDelay(b *ts.Backoff, up time.Duration) time.Duration {
+	if up >= stableConnection {
+		b.Reset()
+	}
+	return b.Next()
+}
+
+// connect mounts acme, starts sessions for its existing windows, and opens
+// the global log, retrying with backoff b until it succeeds or ctx is
+// cancelled.  Sessions left over from a previous acme instance are stopped
+// first.  Cancelling ctx closes the log; the caller calls unwatch once it
+// has finished reading, so callbacks do not pile up on ctx across
+// reconnections.
+func connect(ctx context.Context, t *tracker, b *ts.Backoff) (lr *acme.LogReader, unwatch func() bool, err error) {
+	err = withRetry(ctx, "connect to acme", b, func() error {
+		f, err := acme.Mount()
+		if err != nil {
+			return fmt.Errorf("mount acme: %w", err)
+		}
+		wins, err := f.Windows()
+		if err != nil {
+			return fmt.Errorf("acme.Windows: %w", err)
+		}
+		lr, err = f.Log()
+		if err != nil {
+			return fmt.Errorf("acme.Log: %w", err)
+		}
+		t.reconcile(wins)
+		for _, w := range wins {
+			t.start(w.ID, w.Name)
+		}
+		return nil
+	})
 	if err != nil {
-		l.Fatal("acme.Log", zap.Error(err))
+		return nil, nil, err
 	}
-	defer lr.Close()
+	// Unblock lr.Read on shutdown.
+	unwatch = context.AfterFunc(ctx, func() { lr.Close() })
+	return lr, unwatch, nil
+}
 
-	l.Info("connected to acme log")
+// replayFile feeds the events recorded in file to t, in place of acme's
+// log.  Sessions still talk to the running acme.
+func replayFile(ctx context.Context, file string, t *tracker) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	err = readLog(newReplayLog(f), t)
+	if err != io.EOF {
+		return err
+	}
+	logger.L(ctx).Info("replay finished", zap.String("file", file))
+	return nil
+}
+
+// readLog dispatches acme log events to t until reading fails.
+func readLog(lr logSource, t *tracker) error {
 	for {
 		ev, err := lr.Read()
 		if err != nil {
-			if ctx.Err() != nil {
-				break
-			}
-			l.Fatal("acme log read", zap.Error(err))
+			return err
 		}
 		switch ev.Op {
 		case "new":
-			start(ev.ID, ev.Name)
+			t.start(ev.ID, ev.Name)
+		case "get":
+			t.reload(ev.ID)
+		case "put":
+			t.rename(ev.ID, ev.Name)
+		case "del":
+			t.stop(ev.ID)
 		}
 	}
+}
 
-	wg.Wait()
+// withRetry calls fn until it succeeds, sleeping with jittered exponential
+// backoff between attempts.  b is advanced but not reset, so the caller
+// decides when a success has been stable enough to start over from the
+// base delay.  It returns ctx.Err() if ctx is cancelled first.
+func withRetry(ctx context.Context, what string, b *ts.Backoff, fn func() error) error {
+	for {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+		d := b.Next()
+		logger.L(ctx).Warn(what+" failed; retrying", zap.Error(err), zap.Duration("in", d))
+		if err := sleep(ctx, d); err != nil {
+			return err
+		}
+	}
+}
+
+// sleep waits for d, or returns ctx.Err() if ctx is cancelled first.
+func sleep(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
 }
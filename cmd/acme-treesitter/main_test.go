@@ -0,0 +1,102 @@
+package main
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	ts "github.com/cptaffe/acme-treesitter"
+	"github.com/cptaffe/acme-treesitter/config"
+)
+
+func TestPrintQuery(t *testing.T) {
+	var b strings.Builder
+	if err := printQuery(&b, "go"); err != nil {
+		t.Fatal(err)
+	}
+	if out := b.String(); !strings.HasPrefix(out, "go: ") || !strings.Contains(out, "@comment\tc\n") {
+		t.Errorf("printQuery(go) = %q, want a header and @comment styled c", out)
+	}
+	if err := printQuery(&b, "no-such-lang"); err == nil {
+		t.Error("printQuery(no-such-lang) succeeded, want error")
+	}
+}
+
+func TestWriteService(t *testing.T) {
+	s := &service{
+		Exec: "/usr/local/bin/acme-treesitter",
+		Args: []string{"-config", "/home/glenda/my config.yaml", "-v=true"},
+		Env:  map[string]string{"NAMESPACE": "/tmp/ns.glenda.:0"},
+	}
+	var b strings.Builder
+	if err := writeService(&b, "systemd", s); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`ExecStart=/usr/local/bin/acme-treesitter -config "/home/glenda/my config.yaml" -v=true` + "\n",
+		"Environment=NAMESPACE=/tmp/ns.glenda.:0\n",
+	} {
+		if !strings.Contains(b.String(), want) {
+			t.Errorf("systemd unit missing %q:\n%s", want, b.String())
+		}
+	}
+
+	b.Reset()
+	if err := writeService(&b, "launchd", s); err != nil {
+		t.Fatal(err)
+	}
+	if want := "<string>/home/glenda/my config.yaml</string>"; !strings.Contains(b.String(), want) {
+		t.Errorf("launchd plist missing %q:\n%s", want, b.String())
+	}
+	if err := writeService(&b, "upstart", s); err == nil {
+		t.Error("writeService(upstart) succeeded, want error")
+	}
+}
+
+func TestReconnectDelay(t *testing.T) {
+	b := ts.Backoff{Base: time.Second, Cap: time.Hour}
+	for i := 0; i < 10; i++ {
+		reconnectDelay(&b, time.Second) // flapping
+	}
+	if d := reconnectDelay(&b, 2*stableConnection); d > time.Second {
+		t.Errorf("reconnectDelay after a stable connection = %v, want at most the base", d)
+	}
+	if d := reconnectDelay(&b, time.Second); d > 2*time.Second {
+		t.Errorf("second reconnectDelay after reset = %v, want at most twice the base", d)
+	}
+}
+
+func TestWriteHTML(t *testing.T) {
+	src := []byte("package a // <ä>\n")
+	var b strings.Builder
+	if err := writeHTML(&b, "go", src, nil); err != nil {
+		t.Fatal(err)
+	}
+	want := `<pre class="ts ts-go"><span class="ts-keyword">package</span> a <span class="ts-comment">// &lt;ä&gt;</span>` + "\n</pre>\n"
+	if b.String() != want {
+		t.Errorf("writeHTML =\n%s\nwant\n%s", b.String(), want)
+	}
+
+	b.Reset()
+	if err := writeHTML(&b, "go", src, map[string]string{"comment": "#777"}); err != nil {
+		t.Fatal(err)
+	}
+	want = `<pre class="ts ts-go">package a <span style="color:#777">// &lt;ä&gt;</span>` + "\n</pre>\n"
+	if b.String() != want {
+		t.Errorf("writeHTML with colors =\n%s\nwant\n%s", b.String(), want)
+	}
+}
+
+func TestWriteDefaultConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lib", "acme-treesitter", "config.yaml")
+	if got, err := writeDefaultConfig(path); err != nil || got != path {
+		t.Fatalf("writeDefaultConfig = %q, %v; want %q", got, err, path)
+	}
+	if _, err := config.Load(path); err != nil {
+		t.Errorf("written config does not load: %v", err)
+	}
+	if _, err := writeDefaultConfig(path); err == nil {
+		t.Error("second writeDefaultConfig succeeded, want an error for the existing file")
+	}
+}
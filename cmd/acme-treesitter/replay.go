@@ -0,0 +1,62 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"9fans.net/go/acme"
+)
+
+// logSource is where readLog gets acme log events: the live acme log, or
+// a recording replayed by -replay.
+type logSource interface {
+	Read() (acme.LogEvent, error)
+}
+
+// replayLog reads acme log events from a recording in the log file's own
+// format, one event per line:
+//
+//	12 new /usr/glenda/src/a.go
+//	12 get /usr/glenda/src/a.go
+//	12 del /usr/glenda/src/a.go
+//
+// Blank lines and lines starting with # are skipped.  Read returns io.EOF
+// at the end of the recording.
+type replayLog struct {
+	s    *bufio.Scanner
+	line int
+}
+
+func newReplayLog(r io.Reader) *replayLog {
+	return &replayLog{s: bufio.NewScanner(r)}
+}
+
+func (r *replayLog) Read() (acme.LogEvent, error) {
+	for r.s.Scan() {
+		r.line++
+		text := strings.TrimSpace(r.s.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		f := strings.SplitN(text, " ", 3)
+		if len(f) < 2 {
+			return acme.LogEvent{}, fmt.Errorf("replay line %d: malformed event %q", r.line, text)
+		}
+		id, err := strconv.Atoi(f[0])
+		if err != nil {
+			return acme.LogEvent{}, fmt.Errorf("replay line %d: window id: %w", r.line, err)
+		}
+		ev := acme.LogEvent{ID: id, Op: f[1]}
+		if len(f) == 3 {
+			ev.Name = f[2]
+		}
+		return ev, nil
+	}
+	if err := r.s.Err(); err != nil {
+		return acme.LogEvent{}, err
+	}
+	return acme.LogEvent{}, io.EOF
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"9fans.net/go/plan9/client"
+)
+
+// service describes how a service manager should run this program.
+type service struct {
+	Exec string            // absolute path of the binary
+	Args []string          // flags to pass it, -config included
+	Env  map[string]string // environment acme must be found in
+}
+
+// newService returns the service that runs this binary with config file
+// cfgPath and the extra flags args.  The acme namespace is fixed to the
+// current one, since a service manager's environment has no $DISPLAY or
+// $NAMESPACE to derive it from.
+func newService(cfgPath string, args []string) (*service, error) {
+	if cfgPath == "" || cfgPath == "-" {
+		return nil, fmt.Errorf("-service needs a -config file")
+	}
+	cfg, err := filepath.Abs(cfgPath)
+	if err != nil {
+		return nil, err
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		return nil, err
+	}
+	if exe, err = filepath.EvalSymlinks(exe); err != nil {
+		return nil, err
+	}
+	env := map[string]string{"NAMESPACE": client.Namespace()}
+	if p := os.Getenv("PLAN9"); p != "" {
+		env["PLAN9"] = p
+	}
+	return &service{Exec: exe, Args: append([]string{"-config", cfg}, args...), Env: env}, nil
+}
+
+var serviceTemplates = map[string]*template.Template{
+	"systemd": template.Must(template.New("systemd").Funcs(template.FuncMap{"quote": systemdQuote}).Parse(
+		`[Unit]
+Description=acme-treesitter syntax highlighting for acme
+
+[Service]
+ExecStart={{quote .Exec}}{{range .Args}} {{quote .}}{{end}}
+{{- range $k, $v := .Env}}
+Environment={{quote (printf "%s=%s" $k $v)}}
+{{- end}}
+Restart=on-failure
+
+[Install]
+WantedBy=default.target
+`)),
+	"launchd": template.Must(template.New("launchd").Funcs(template.FuncMap{"xml": xmlEscape}).Parse(
+		`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>Label</key>
+	<string>com.github.cptaffe.acme-treesitter</string>
+	<key>ProgramArguments</key>
+	<array>
+		<string>{{xml .Exec}}</string>
+{{- range .Args}}
+		<string>{{xml .}}</string>
+{{- end}}
+	</array>
+	<key>EnvironmentVariables</key>
+	<dict>
+{{- range $k, $v := .Env}}
+		<key>{{xml $k}}</key>
+		<string>{{xml $v}}</string>
+{{- end}}
+	</dict>
+	<key>RunAtLoad</key>
+	<true/>
+	<key>KeepAlive</key>
+	<true/>
+</dict>
+</plist>
+`)),
+}
+
+// writeService writes s to w as a unit file for manager, "systemd" (a
+// user unit) or "launchd" (a LaunchAgent plist).
+func writeService(w io.Writer, manager string, s *service) error {
+	t, ok := serviceTemplates[manager]
+	if !ok {
+		return fmt.Errorf("unknown service manager %q; want systemd or launchd", manager)
+	}
+	return t.Execute(w, s)
+}
+
+// systemdQuote quotes s for a systemd command line or Environment= value
+// if it needs it.
+func systemdQuote(s string) string {
+	if s != "" && !strings.ContainsAny(s, " \t\"'\\$%;") {
+		return s
+	}
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, `$`, `$$`, `%`, `%%`)
+	return `"` + r.Replace(s) + `"`
+}
+
+func xmlEscape(s string) string {
+	var b strings.Builder
+	template.HTMLEscape(&b, []byte(s))
+	return b.String()
+}
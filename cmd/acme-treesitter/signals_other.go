@@ -10,3 +10,6 @@ import (
 // shutdownSignals are the OS signals that trigger a clean exit.
 // SIGTERM is included for launchd/systemd service managers.
 var shutdownSignals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+
+// reloadSignals are the OS signals that reload the config file.
+var reloadSignals = []os.Signal{syscall.SIGHUP}
@@ -6,3 +6,7 @@ import "os"
 
 // shutdownSignals are the OS signals that trigger a clean exit.
 var shutdownSignals = []os.Signal{os.Interrupt}
+
+// reloadSignals are the OS signals that reload the config file.  Plan 9
+// has no SIGHUP, so the config is read only at startup.
+var reloadSignals []os.Signal
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"9fans.net/go/acme"
+	ts "github.com/cptaffe/acme-treesitter"
+	"github.com/cptaffe/acme-treesitter/logger"
+	"go.uber.org/zap"
+)
+
+// tracker owns the per-window RunWindow goroutines, at most one per acme
+// window ID.
+type tracker struct {
+	ctx context.Context
+	wg  sync.WaitGroup
+
+	mu        sync.Mutex
+	enabled   bool               // whether to start sessions; guarded by mu
+	handlers  []ts.Handler       // for new sessions; guarded by mu
+	opts      ts.Options         // for new sessions; guarded by mu
+	active    map[int]*winHandle // guarded by mu
+	overrides map[int]string     // language forced by Ts; guarded by mu
+}
+
+// winHandle is the main loop's view of one running RunWindow goroutine.
+type winHandle struct {
+	name   string
+	cancel context.CancelFunc
+	reload chan struct{} // buffered; see RunWindow
+	done   chan struct{} // closed once RunWindow has returned
+}
+
+func newTracker(ctx context.Context, handlers []ts.Handler, opts ts.Options, enabled bool) *tracker {
+	return &tracker{
+		ctx:       ctx,
+		handlers:  handlers,
+		opts:      opts,
+		enabled:   enabled,
+		active:    make(map[int]*winHandle),
+		overrides: make(map[int]string),
+	}
+}
+
+// start launches a RunWindow goroutine for window id unless one is already
+// running, highlighting is disabled, or Ts turned it off for the window.
+func (t *tracker) start(id int, name string) {
+	t.mu.Lock()
+	if _, ok := t.active[id]; ok || !t.enabled {
+		t.mu.Unlock()
+		return
+	}
+	handlers, opts := t.handlers, t.opts
+	switch lang := t.overrides[id]; lang {
+	case ts.LanguageOff:
+		t.mu.Unlock()
+		return
+	case "":
+	default:
+		opts.Language = lang
+	}
+	ctx, cancel := context.WithCancel(t.ctx)
+	h := &winHandle{name: name, cancel: cancel, reload: make(chan struct{}, 1), done: make(chan struct{})}
+	t.active[id] = h
+	t.mu.Unlock()
+
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		defer close(h.done)
+		defer func() {
+			cancel()
+			t.mu.Lock()
+			// The entry may already belong to a newer goroutine if this one
+			// was cancelled and the ID reused.
+			if t.active[id] == h {
+				delete(t.active, id)
+			}
+			t.mu.Unlock()
+		}()
+		ts.RunWindow(ctx, id, name, handlers, opts, h.reload)
+	}()
+}
+
+// setLanguage handles "Ts lang": window id is highlighted as lang from now
+// on, or not at all if lang is "off".  Any running session is stopped,
+// and its layer removed, before the new one starts.
+func (t *tracker) setLanguage(id int, name, lang string) error {
+	if lang != ts.LanguageOff && !ts.HasLanguage(lang) {
+		return fmt.Errorf("unknown language %q", lang)
+	}
+	t.mu.Lock()
+	t.overrides[id] = lang
+	h, ok := t.active[id]
+	if ok {
+		h.cancel()
+		delete(t.active, id)
+	}
+	t.mu.Unlock()
+	if ok {
+		<-h.done
+	}
+	logger.L(t.ctx).Info("language set by Ts", zap.Int("window", id), zap.String("lang", lang))
+	t.start(id, name)
+	return nil
+}
+
+// reconfigure makes handlers, opts and enabled apply to the sessions
+// started from now on.  If enabled is false every running session is
+// stopped.  Otherwise running sessions keep the settings they started
+// with, except those whose file name maps to a different language under
+// handlers: they are stopped, and returned as window names by ID to be
+// started again.  Windows whose language Ts set are left alone.
+func (t *tracker) reconfigure(handlers []ts.Handler, opts ts.Options, enabled bool) map[int]string {
+	t.mu.Lock()
+	old := t.handlers
+	t.handlers, t.opts, t.enabled = handlers, opts, enabled
+	stopped := make(map[int]string)
+	var done []chan struct{}
+	for id, h := range t.active {
+		if !enabled {
+			h.cancel()
+			delete(t.active, id)
+			done = append(done, h.done)
+			continue
+		}
+		if _, ok := t.overrides[id]; ok {
+			continue
+		}
+		if ts.LanguageFor(old, h.name, nil) != ts.LanguageFor(handlers, h.name, nil) {
+			h.cancel()
+			delete(t.active, id)
+			stopped[id] = h.name
+			done = append(done, h.done)
+		}
+	}
+	t.mu.Unlock()
+	for _, d := range done {
+		<-d
+	}
+	switch {
+	case !enabled && len(done) > 0:
+		logger.L(t.ctx).Info("highlighting disabled by config; stopped all sessions", zap.Int("count", len(done)))
+	case len(stopped) > 0:
+		logger.L(t.ctx).Info("stopped sessions whose language changed", zap.Int("count", len(stopped)))
+	}
+	return stopped
+}
+
+// rename handles a put of window id under name.  A Put to a new file
+// renames the window, and its session is stopped and started afresh: the
+// language, the reopen cache entry, the capture rules and the log fields
+// all go by the name a session starts with.  A window with no session is
+// started, in case its new name, or content, now has a language.
+func (t *tracker) rename(id int, name string) {
+	t.mu.Lock()
+	h, ok := t.active[id]
+	renamed := ok && h.name != name
+	if renamed {
+		h.cancel()
+		delete(t.active, id)
+	}
+	t.mu.Unlock()
+	if renamed {
+		<-h.done
+		logger.L(t.ctx).Info("window renamed", zap.Int("window", id), zap.String("from", h.name), zap.String("to", name))
+	}
+	t.start(id, name)
+}
+
+// stop handles the deletion of window id: its session, if any, is
+// cancelled at once rather than left to notice the end of the window's
+// log, so its layer is deleted promptly, and any language Ts set for the
+// window is forgotten.
+func (t *tracker) stop(id int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.overrides, id)
+	if h, ok := t.active[id]; ok {
+		h.cancel()
+		delete(t.active, id)
+	}
+}
+
+// reload asks the goroutine for window id, if any, to re-highlight from
+// scratch.
+func (t *tracker) reload(id int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if h, ok := t.active[id]; ok {
+		select {
+		case h.reload <- struct{}{}:
+		default: // a reload is already pending
+		}
+	}
+}
+
+// reconcile stops the goroutines for windows that are not in wins, or
+// whose ID now names a different file.  Both happen when acme restarts:
+// window IDs start over, so goroutines left over from the old instance
+// would otherwise retry against windows that no longer exist.  It returns
+// once they have exited, so a session started afterwards for a reused ID
+// does not have its layer deleted from under it.
+func (t *tracker) reconcile(wins []acme.WinInfo) {
+	names := make(map[int]string, len(wins))
+	for _, w := range wins {
+		names[w.ID] = w.Name
+	}
+	t.mu.Lock()
+	for id := range t.overrides {
+		if _, ok := names[id]; !ok {
+			delete(t.overrides, id)
+		}
+	}
+	var done []chan struct{}
+	for id, h := range t.active {
+		if name, ok := names[id]; !ok || name != h.name {
+			h.cancel()
+			delete(t.active, id)
+			done = append(done, h.done)
+		}
+	}
+	t.mu.Unlock()
+	for _, d := range done {
+		<-d
+	}
+	if len(done) > 0 {
+		logger.L(t.ctx).Info("stopped stale window sessions", zap.Int("count", len(done)))
+	}
+}
+
+// wait blocks until every RunWindow goroutine has returned.
+func (t *tracker) wait() {
+	t.wg.Wait()
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"context"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"9fans.net/go/acme"
+	ts "github.com/cptaffe/acme-treesitter"
+	"github.com/cptaffe/acme-treesitter/config"
+)
+
+func TestReconcile(t *testing.T) {
+	tr := newTracker(context.Background(), nil, ts.Options{}, true)
+	cancelled := make(map[int]bool)
+	for id, name := range map[int]string{1: "/a.go", 2: "/b.go", 3: "/c.go"} {
+		id := id
+		h := &winHandle{name: name, cancel: func() { cancelled[id] = true }, done: make(chan struct{})}
+		close(h.done)
+		tr.active[id] = h
+	}
+
+	// After an acme restart, ID 1 is a different file and ID 3 is gone.
+	tr.reconcile([]acme.WinInfo{{ID: 1, Name: "/new.go"}, {ID: 2, Name: "/b.go"}})
+
+	for id, want := range map[int]bool{1: true, 2: false, 3: true} {
+		if cancelled[id] != want {
+			t.Errorf("window %d cancelled = %v, want %v", id, cancelled[id], want)
+		}
+		if _, ok := tr.active[id]; ok == want {
+			t.Errorf("window %d still active = %v, want %v", id, ok, !want)
+		}
+	}
+}
+
+func TestStartDisabled(t *testing.T) {
+	tr := newTracker(context.Background(), nil, ts.Options{}, false)
+	tr.start(1, "/a.go")
+	if len(tr.active) != 0 {
+		t.Errorf("disabled tracker started %d sessions, want none", len(tr.active))
+	}
+}
+
+func TestSetLanguage(t *testing.T) {
+	tr := newTracker(context.Background(), nil, ts.Options{}, true)
+	if err := tr.setLanguage(1, "/a.txt", "no-such-lang"); err == nil {
+		t.Error("setLanguage(no-such-lang) succeeded, want error")
+	}
+
+	cancelled := false
+	h := &winHandle{name: "/a.go", cancel: func() { cancelled = true }, done: make(chan struct{})}
+	close(h.done)
+	tr.active[1] = h
+	if err := tr.setLanguage(1, "/a.go", ts.LanguageOff); err != nil {
+		t.Fatal(err)
+	}
+	if !cancelled {
+		t.Error("running session not stopped by Ts off")
+	}
+	tr.start(1, "/a.go")
+	if _, ok := tr.active[1]; ok {
+		t.Error("start after Ts off launched a session")
+	}
+
+	// The override goes away with the window.
+	tr.reconcile(nil)
+	if _, ok := tr.overrides[1]; ok {
+		t.Error("override kept after the window was gone")
+	}
+}
+
+func TestStopOnDel(t *testing.T) {
+	tr := newTracker(context.Background(), nil, ts.Options{}, true)
+	cancelled := false
+	tr.active[2] = &winHandle{name: "/a.go", cancel: func() { cancelled = true }}
+	tr.overrides[2] = "c"
+
+	if err := readLog(newReplayLog(strings.NewReader("2 del /a.go\n")), tr); err != io.EOF {
+		t.Fatalf("readLog = %v, want io.EOF", err)
+	}
+	if !cancelled {
+		t.Error("del did not cancel the session")
+	}
+	if _, ok := tr.active[2]; ok {
+		t.Error("deleted window still active")
+	}
+	if _, ok := tr.overrides[2]; ok {
+		t.Error("override kept after del")
+	}
+}
+
+func TestReadLogReplay(t *testing.T) {
+	tr := newTracker(context.Background(), nil, ts.Options{}, true)
+	h := &winHandle{name: "/a.go", cancel: func() {}, reload: make(chan struct{}, 1)}
+	tr.active[4] = h
+
+	const rec = `# recorded session
+4 get /a.go
+
+9 get /not-tracked.go
+`
+	if err := readLog(newReplayLog(strings.NewReader(rec)), tr); err != io.EOF {
+		t.Fatalf("readLog = %v, want io.EOF", err)
+	}
+	select {
+	case <-h.reload:
+	default:
+		t.Error("replayed get did not reload window 4")
+	}
+
+	if err := readLog(newReplayLog(strings.NewReader("x new /a.go\n")), tr); err == nil || err == io.EOF {
+		t.Errorf("readLog(bad id) = %v, want parse error", err)
+	}
+}
+
+func TestReconfigure(t *testing.T) {
+	compile := func(src string) []ts.Handler {
+		cfg, err := config.Read(strings.NewReader(src), "test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		handlers, err := ts.CompileHandlers(cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return handlers
+	}
+	old := compile("filename_handlers:\n  - {pattern: '\\.h$', language_id: c}\n  - {pattern: '\\.go$', language_id: go}\n")
+	tr := newTracker(context.Background(), old, ts.Options{}, true)
+	cancelled := make(map[int]bool)
+	for id, name := range map[int]string{1: "/a.h", 2: "/b.go", 3: "/c.h"} {
+		id := id
+		h := &winHandle{name: name, cancel: func() { cancelled[id] = true }, done: make(chan struct{})}
+		close(h.done)
+		tr.active[id] = h
+	}
+	tr.overrides[3] = "c" // set by Ts
+
+	newer := compile("filename_handlers:\n  - {pattern: '\\.h$', language_id: cpp}\n  - {pattern: '\\.go$', language_id: go}\n")
+	stopped := tr.reconfigure(newer, ts.Options{}, true)
+
+	if want := map[int]string{1: "/a.h"}; !reflect.DeepEqual(stopped, want) {
+		t.Errorf("reconfigure stopped %v, want %v", stopped, want)
+	}
+	for id, want := range map[int]bool{1: true, 2: false, 3: false} {
+		if cancelled[id] != want {
+			t.Errorf("window %d cancelled = %v, want %v", id, cancelled[id], want)
+		}
+	}
+
+	// Disabling stops every session, even those whose language Ts set,
+	// and starts no new ones.
+	if stopped := tr.reconfigure(newer, ts.Options{}, false); len(stopped) != 0 {
+		t.Errorf("disabling reconfigure returned %v, want nothing to restart", stopped)
+	}
+	for _, id := range []int{2, 3} {
+		if !cancelled[id] {
+			t.Errorf("window %d not cancelled when disabled", id)
+		}
+	}
+	if len(tr.active) != 0 {
+		t.Errorf("%d sessions still active when disabled", len(tr.active))
+	}
+	tr.start(4, "/d.go")
+	if _, ok := tr.active[4]; ok {
+		t.Error("start while disabled launched a session")
+	}
+
+	// Enabling again lets start, as called by startWindows, launch
+	// sessions.
+	tr.reconfigure(newer, ts.Options{}, true)
+	if !tr.enabled {
+		t.Error("reconfigure did not re-enable the tracker")
+	}
+}
+
+func TestRename(t *testing.T) {
+	cfg, err := config.Read(strings.NewReader("filename_handlers:\n  - {pattern: '\\.(c|h)$', language_id: c}\n  - {pattern: '\\.go$', language_id: go}\n"), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	handlers, err := ts.CompileHandlers(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := newTracker(context.Background(), handlers, ts.Options{}, false) // keep start from launching sessions
+	cancelled := false
+	h := &winHandle{name: "/a.c", cancel: func() { cancelled = true }, done: make(chan struct{})}
+	close(h.done)
+	tr.active[1] = h
+
+	if err := readLog(newReplayLog(strings.NewReader("1 put /a.c\n")), tr); err != io.EOF {
+		t.Fatalf("readLog = %v, want io.EOF", err)
+	}
+	if cancelled {
+		t.Error("put under the same name stopped the session")
+	}
+	// A session keeps the name it started with, so even a name of the
+	// same language restarts it.
+	if err := readLog(newReplayLog(strings.NewReader("1 put /b.h\n")), tr); err != io.EOF {
+		t.Fatalf("readLog = %v, want io.EOF", err)
+	}
+	if !cancelled {
+		t.Error("put under a new name did not stop the session")
+	}
+	if _, ok := tr.active[1]; ok {
+		t.Error("renamed window still has its old session")
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"path/filepath"
 	"regexp"
+	"regexp/syntax"
 	"strings"
+	"time"
 
 	"github.com/cptaffe/acme-treesitter/config"
 )
@@ -12,7 +14,7 @@ import (
 // Handler is a compiled FilenameHandler, ready for matching.
 type Handler struct {
 	re   *regexp.Regexp
-	lang *Language // nil if LanguageID is unsupported
+	lang *Language // nil if no LanguageID candidate is supported
 }
 
 // CompileHandlers pre-compiles the FilenameHandler regexes from cfg.
@@ -20,28 +22,323 @@ type Handler struct {
 func CompileHandlers(cfg *config.Config) ([]Handler, error) {
 	out := make([]Handler, 0, len(cfg.FilenameHandlers))
 	for _, fh := range cfg.FilenameHandlers {
-		re, err := regexp.Compile(fh.Pattern)
+		pat := fh.Pattern
+		if cfg.AnchorPatterns {
+			pat = anchorPattern(pat)
+		}
+		re, err := regexp.Compile(pat)
 		if err != nil {
 			return nil, fmt.Errorf("FilenameHandler pattern %q: %w", fh.Pattern, err)
 		}
 		out = append(out, Handler{
 			re:   re,
-			lang: langByID(fh.LanguageID),
+			lang: firstRegistered(fh.LanguageID),
 		})
 	}
 	return out, nil
 }
 
+// anchorPattern wraps pat in \A...\z unless it already uses an anchor
+// somewhere.  An unparsable pattern is returned as is, for
+// regexp.Compile to report.
+func anchorPattern(pat string) string {
+	re, err := syntax.Parse(pat, syntax.Perl)
+	if err != nil || hasAnchor(re) {
+		return pat
+	}
+	return `\A(?:` + pat + `)\z`
+}
+
+// hasAnchor reports whether re contains a line or text anchor.
+func hasAnchor(re *syntax.Regexp) bool {
+	switch re.Op {
+	case syntax.OpBeginLine, syntax.OpEndLine, syntax.OpBeginText, syntax.OpEndText:
+		return true
+	}
+	for _, sub := range re.Sub {
+		if hasAnchor(sub) {
+			return true
+		}
+	}
+	return false
+}
+
+// firstRegistered returns the Language for the first id in ids that has a
+// registered grammar, or nil if none do.
+func firstRegistered(ids []string) *Language {
+	for _, id := range ids {
+		if l := langByID(id); l != nil {
+			return l
+		}
+	}
+	return nil
+}
+
+// CaptureRule is a compiled config.CaptureRule.
+type CaptureRule struct {
+	file, text *regexp.Regexp // nil matches anything
+	capture    string
+	remap      string
+}
+
+// CompileCaptureRules pre-compiles the CaptureRules regexes from cfg.
+func CompileCaptureRules(cfg *config.Config) ([]CaptureRule, error) {
+	var out []CaptureRule
+	for i, cr := range cfg.CaptureRules {
+		r := CaptureRule{capture: cr.Capture, remap: cr.Remap}
+		var err error
+		if r.file, err = compileOptional(cr.File); err != nil {
+			return nil, fmt.Errorf("capture_rules[%d] file %q: %w", i, cr.File, err)
+		}
+		if r.text, err = compileOptional(cr.Text); err != nil {
+			return nil, fmt.Errorf("capture_rules[%d] text %q: %w", i, cr.Text, err)
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+// compileOptional compiles expr, or returns nil if it is empty.
+func compileOptional(expr string) (*regexp.Regexp, error) {
+	if expr == "" {
+		return nil, nil
+	}
+	return regexp.Compile(expr)
+}
+
+// captureFilter decides the fate of one capture: it returns the capture
+// name to style text as, or false to drop the capture.
+type captureFilter func(capture string, text []byte) (string, bool)
+
+// captureFilterFor returns the filter applying rules to window name, or
+// nil if no rule concerns that window.
+func captureFilterFor(rules []CaptureRule, name string) captureFilter {
+	var active []CaptureRule
+	for _, r := range rules {
+		if r.file == nil || r.file.MatchString(name) {
+			active = append(active, r)
+		}
+	}
+	if len(active) == 0 {
+		return nil
+	}
+	return func(capture string, text []byte) (string, bool) {
+		for _, r := range active {
+			if capture != r.capture && !strings.HasPrefix(capture, r.capture+".") {
+				continue
+			}
+			if r.text != nil && !r.text.Match(text) {
+				continue
+			}
+			return r.remap, r.remap != ""
+		}
+		return capture, true
+	}
+}
+
+// Options holds the per-window tunables derived from the config file.
+type Options struct {
+	// QueryTimeout caps capture iteration per highlight; 0 = unlimited.
+	QueryTimeout time.Duration
+
+	// TagTiming appends the last highlight duration to the window tag,
+	// e.g. "[ts:go 4ms]".  Set from the -tagtiming flag.
+	TagTiming bool
+
+	// KeepLayers leaves each window's layer, with its last entries, in
+	// acme-styles when the session ends, for debugging.  Set from the
+	// -keeplayers flag; -gclayers cleans up afterwards.  A retried
+	// session leaves a layer behind per attempt.
+	KeepLayers bool
+
+	// ReopenCache is how long a closed window's highlight is kept for reuse
+	// if the same file is reopened unchanged; 0 disables the cache.
+	ReopenCache time.Duration
+
+	// Debounce is the delay between an edit and the re-highlight.
+	// DebounceLanguages overrides it for the language IDs it lists.
+	Debounce          time.Duration
+	DebounceLanguages map[string]time.Duration
+
+	// DebounceEdits, if positive, re-highlights after that many edits even
+	// if the debounce timer has not yet fired.
+	DebounceEdits int
+
+	// OpenOnly skips the edit-watch loop after the initial highlight.
+	OpenOnly bool
+
+	// Preview, if non-nil, matches the names of preview windows, of which
+	// only the first PreviewLines lines are highlighted and edits are not
+	// followed.  RunWindow zeroes PreviewLines for other windows.
+	Preview      *regexp.Regexp
+	PreviewLines int
+
+	// Resync is the approximate interval between unconditional full
+	// re-highlights; 0 disables them.
+	Resync time.Duration
+
+	// DetectBytes is how much of the body content detection (shebangs)
+	// may read.
+	DetectBytes int
+
+	// SniffShell classifies extensionless files without a shebang as
+	// bash if their first lines look like shell.
+	SniffShell bool
+
+	// FollowExec detects the language of a shell-shebang file from the
+	// interpreter an early "exec" line re-runs it with.
+	FollowExec bool
+
+	// RetryBase and RetryCap bound the backoff between session retries.
+	RetryBase, RetryCap time.Duration
+
+	// FoldDir, if set, is where fold ranges are published, one file per
+	// window ID.
+	FoldDir string
+
+	// MaxBytes maps language IDs to the largest body that is highlighted;
+	// bigger bodies are left unstyled.  0 means no limit.  Languages
+	// absent from it are limited by MaxFileBytes, likewise 0 for none.
+	MaxBytes     map[string]int
+	MaxFileBytes int
+
+	// LiteOversize highlights only comments and strings in bodies over
+	// the MaxBytes limit.
+	LiteOversize bool
+
+	// OnUnmatched is what to do with a window no handler or shebang
+	// matches: one of the config.Unmatched* values.  DefaultLanguage is
+	// the language ID used for config.UnmatchedDefault.
+	OnUnmatched     string
+	DefaultLanguage string
+
+	// CaptureRules, from CompileCaptureRules, drop or rename captures.
+	CaptureRules []CaptureRule
+
+	// MatchBrackets, if positive, is how often the window's dot is polled
+	// to highlight the bracket pair at it.
+	MatchBrackets time.Duration
+
+	// CaptureFallback limits how many trailing components of a capture
+	// name may be dropped to find its style: 0 for any number, a negative
+	// value for none (exact matches only).
+	CaptureFallback int
+
+	// CapturePriority, if non-nil, decides which of two overlapping
+	// captures styles a byte instead of query order.
+	CapturePriority map[string]int
+
+	// MergeGap, if positive, is the widest unstyled gap, in runes, between
+	// two same-style entries that are merged into one.
+	MergeGap int
+
+	// TodoMarkers are highlighted inside comments; nil disables that.
+	TodoMarkers []string
+
+	// HighlightErrors styles ERROR and MISSING nodes as @error, over any
+	// capture.
+	HighlightErrors bool
+
+	// Language, if set, is used instead of detecting the window's
+	// language.  Set per window by the Ts command.
+	Language string
+}
+
+// maxBytes returns the body size limit for lang, or 0 for none.
+func (o Options) maxBytes(lang *Language) int {
+	if n, ok := o.MaxBytes[lang.Name]; ok {
+		return n
+	}
+	return o.MaxFileBytes
+}
+
+// debounce returns the delay between an edit and the re-highlight for
+// lang: its entry in DebounceLanguages, or Debounce if it has none.
+func (o Options) debounce(lang *Language) time.Duration {
+	if d, ok := o.DebounceLanguages[lang.Name]; ok && d > 0 {
+		return d
+	}
+	return o.Debounce
+}
+
+// NewOptions extracts the per-window tunables from cfg, which must have
+// come from config.Load or config.Read so that defaults are filled in.
+func NewOptions(cfg *config.Config) Options {
+	o := Options{
+		QueryTimeout:      cfg.QueryTimeout,
+		ReopenCache:       cfg.ReopenCache,
+		Debounce:          cfg.Debounce,
+		DebounceLanguages: cfg.DebounceLanguages,
+		DebounceEdits:     cfg.DebounceEdits,
+		OpenOnly:          cfg.OpenOnly,
+		Resync:            cfg.Resync,
+		MatchBrackets:     cfg.MatchBrackets,
+		CaptureFallback:   cfg.FallbackLevels(),
+		CapturePriority:   cfg.CapturePriority,
+		MergeGap:          cfg.MergeGap,
+		HighlightErrors:   cfg.HighlightErrors,
+		DetectBytes:       cfg.DetectBytes,
+		SniffShell:        cfg.SniffShell,
+		FollowExec:        cfg.FollowExec,
+		RetryBase:         cfg.RetryBase,
+		RetryCap:          cfg.RetryCap,
+		FoldDir:           cfg.FoldDir,
+		MaxBytes:          cfg.MaxBytes,
+		MaxFileBytes:      max(cfg.MaxFileBytes, 0),
+		LiteOversize:      cfg.LiteOversize,
+		OnUnmatched:       cfg.OnUnmatched,
+		DefaultLanguage:   cfg.DefaultLanguage,
+	}
+	if cfg.Todo {
+		o.TodoMarkers = cfg.TodoMarkers
+	}
+	if cfg.PreviewPattern != "" {
+		o.Preview = regexp.MustCompile(cfg.PreviewPattern) // checked by validate
+		o.PreviewLines = cfg.PreviewLines
+	}
+	return o
+}
+
+// newTweaks builds the tweaks opts calls for in window name.
+func newTweaks(opts Options, name string) tweaks {
+	tw := tweaks{
+		filter:   captureFilterFor(opts.CaptureRules, name),
+		fallback: opts.CaptureFallback,
+		priority: opts.CapturePriority,
+		mergeGap: opts.MergeGap,
+		errors:   opts.HighlightErrors,
+	}
+	for _, m := range opts.TodoMarkers {
+		if m != "" {
+			tw.todo = append(tw.todo, []byte(m))
+		}
+	}
+	return tw
+}
+
 // detectLanguage returns the Language for filename name using the compiled
-// handler list, or nil if no pattern matches or the matched language ID has
-// no registered grammar.
-func detectLanguage(handlers []Handler, name string) *Language {
+// handler list.  matched reports whether any pattern matched; lang is nil if
+// none did or the matched language ID has no registered grammar.
+func detectLanguage(handlers []Handler, name string) (lang *Language, matched bool) {
 	for _, h := range handlers {
 		if h.re.MatchString(name) {
-			return h.lang
+			return h.lang, true
 		}
 	}
-	return nil
+	return nil, false
+}
+
+// LanguageFor returns the language ID for a file outside acme, named name
+// and starting with head: by handler, then by shebang.  It returns "" if
+// neither gives a registered language.
+func LanguageFor(handlers []Handler, name string, head []byte) string {
+	if lang, _ := detectLanguage(handlers, name); lang != nil {
+		return lang.Name
+	}
+	if lang := detectByShebang(firstLine(head)); lang != nil {
+		return lang.Name
+	}
+	return ""
 }
 
 // shebangs maps interpreter base-names to language IDs.
@@ -64,7 +361,7 @@ var shebangs = map[string]string{
 	"deno":    "javascript",
 	"node":    "javascript",
 	"nodejs":  "javascript",
-	"ts-node": "javascript",
+	"ts-node": "typescript",
 	// Java
 	"java":  "java",
 	"jbang": "java",
@@ -74,6 +371,20 @@ var shebangs = map[string]string{
 	"scala3": "scala",
 	// Rust
 	"rust-script": "rust",
+	// Clojure
+	"bb":      "clojure", // babashka
+	"clojure": "clojure",
+	// AWK
+	"awk":  "awk",
+	"gawk": "awk",
+	"mawk": "awk",
+	"nawk": "awk",
+	// Racket
+	"racket": "racket",
+	// Scheme
+	"guile":  "scheme",
+	"chez":   "scheme",
+	"scheme": "scheme", // Chez Scheme
 }
 
 // detectByShebang parses the first line of a file and returns a Language if
@@ -92,8 +403,12 @@ func detectByShebang(firstLine string) *Language {
 // It handles the common forms:
 //
 //	#!/bin/bash
+//	#!/bin/sh -e                             (interpreter flags are ignored)
 //	#!/usr/bin/env python3
 //	#!/usr/bin/env -S scala -classpath lib   (env flags are skipped)
+//
+// As with the kernel's own #! handling, the interpreter path ends at the
+// first blank, so a path containing spaces cannot be expressed.
 func shebanInterpreter(line string) string {
 	if !strings.HasPrefix(line, "#!") {
 		return ""
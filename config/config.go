@@ -2,8 +2,14 @@
 package config
 
 import (
+	_ "embed"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
@@ -14,29 +20,437 @@ type Config struct {
 	// config files.  The palette is managed by acme-styles.
 	StyleFile string `yaml:"style_file"`
 
+	// Enabled turns highlighting on or off globally.  When false the tool
+	// stays connected to acme but starts no window sessions, which is
+	// useful for ruling it out when diagnosing an acme slowdown.  Defaults
+	// to true when absent.
+	Enabled *bool `yaml:"enabled"`
+
 	// FilenameHandlers maps filename patterns to grammar language IDs.
 	// Evaluated in order; first match wins.  Patterns are Go regular
 	// expressions; the same regexes used in acme-lsp's FilenameHandlers work
 	// here unchanged.
 	FilenameHandlers []FilenameHandler `yaml:"filename_handlers"`
 
+	// AnchorPatterns, if set, makes every filename handler pattern that
+	// has no anchors of its own (^, $, \A, \z) match the whole window
+	// name rather than any part of it, so a careless "py" no longer
+	// matches /src/happy.go.  A pattern such as '.*/Makefile' then has to
+	// account for the directory.  Off by default.
+	AnchorPatterns bool `yaml:"anchor_patterns"`
+
+	// QueryTimeout bounds how long a single highlight pass may spend
+	// iterating query captures (e.g. "50ms").  When the budget runs out the
+	// captures collected so far are applied and the rest of the file is
+	// left unstyled.  Zero means no limit.
+	QueryTimeout time.Duration `yaml:"query_timeout"`
+
+	// ReopenCache keeps a closed window's highlight for this long (e.g.
+	// "5s") so reopening the same, unchanged file skips the initial parse.
+	// Useful when acme windows are closed and reopened in quick
+	// succession.  Zero disables the cache.
+	ReopenCache time.Duration `yaml:"reopen_cache"`
+
+	// Debounce is how long after an edit the window is re-highlighted.
+	// Defaults to 200ms.
+	Debounce time.Duration `yaml:"debounce"`
+
+	// DebounceLanguages overrides Debounce per language, for grammars
+	// that are much slower or faster to parse than the rest.  Keys are
+	// language IDs:
+	//
+	//	debounce_languages:
+	//	  cpp: 500ms
+	//	  bash: 80ms
+	DebounceLanguages map[string]time.Duration `yaml:"debounce_languages"`
+
+	// DebounceEdits, when positive, re-highlights after that many edits
+	// even if the debounce has not fired yet, giving a steady
+	// refresh cadence during long editing bursts.  Zero means time-based
+	// debounce only.
+	DebounceEdits int `yaml:"debounce_edits"`
+
+	// OpenOnly highlights each window once when it is opened and then
+	// stops watching it for edits, for read-only browsing.  A Get still
+	// re-highlights.  The -openonly flag also turns it on.
+	OpenOnly bool `yaml:"open_only"`
+
+	// PreviewPattern, if set, is a regexp matching the names of transient
+	// preview windows, such as files plumbed just to glance at.  Only the
+	// first PreviewLines lines of a preview window are highlighted, once
+	// when it opens and again after a Get; edits are not followed.
+	// PreviewLines defaults to 200.
+	PreviewPattern string `yaml:"preview_pattern"`
+	PreviewLines   int    `yaml:"preview_lines"`
+
+	// Resync, if positive, re-reads and re-highlights every window about
+	// that often (jittered) whether or not it was edited, correcting any
+	// drift from a missed log event.  Zero, the default, disables it.
+	Resync time.Duration `yaml:"resync"`
+
+	// DetectBytes caps how much of a window body is read for content-based
+	// language detection such as shebang lines.  Highlighting still reads
+	// the whole body.  Defaults to 1024.
+	DetectBytes int `yaml:"detect_bytes"`
+
+	// SniffShell, if set, highlights an extensionless file that no
+	// handler or shebang matches as bash when its first lines use
+	// shell-only constructs, as sourced scripts such as "configure" or
+	// "install" do.  It runs last and errs towards leaving files alone.
+	// Off by default.
+	SniffShell bool `yaml:"sniff_shell"`
+
+	// FollowExec, if set, looks past a shell shebang for a polyglot
+	// header that hands the file to another interpreter, as in
+	//
+	//	#!/bin/sh
+	//	''''exec python3 "$0" "$@" # '''
+	//
+	// and highlights the file in that interpreter's language.  Off by
+	// default.
+	FollowExec bool `yaml:"follow_exec"`
+
+	// RetryBase and RetryCap bound the jittered exponential backoff between
+	// retries of a failed window session.  Default 100ms and 5s.
+	RetryBase time.Duration `yaml:"retry_base"`
+	RetryCap  time.Duration `yaml:"retry_cap"`
+
+	// ReconnectBase and ReconnectCap bound the backoff between attempts to
+	// reconnect to acme.  Default 200ms and 30s.
+	ReconnectBase time.Duration `yaml:"reconnect_base"`
+	ReconnectCap  time.Duration `yaml:"reconnect_cap"`
+
+	// FoldDir, if set, enables fold hints for external tools: after each
+	// highlight, the fold ranges of window <id> are written to
+	// FoldDir/<id> as acme line addresses ("12,30"), one per line, and
+	// the file is removed when the window closes.  Only languages with a
+	// queries/folds/<id>.scm query produce ranges.
+	FoldDir string `yaml:"fold_dir"`
+
+	// QueryDir, if set, is a directory of highlight queries that replace
+	// the built-in ones: QueryDir/<id>.scm, where present, is used for
+	// language id.  A query that does not compile is logged and the
+	// built-in one kept.  Use -dumpquery to see what a language captures.
+	QueryDir string `yaml:"query_dir"`
+
+	// MaxBytes limits highlighting per language: a window whose body is
+	// larger than the limit for its language is left unstyled.  Keys are
+	// language IDs:
+	//
+	//	max_bytes:
+	//	  javascript: 1048576 # minified bundles are cheap to parse
+	//	  markdown: 131072
+	//
+	// Languages not listed are limited by MaxFileBytes; list one with 0
+	// to lift the limit for it.
+	MaxBytes map[string]int `yaml:"max_bytes"`
+
+	// MaxFileBytes limits highlighting in languages that max_bytes does
+	// not list.  Defaults to 2 MiB; a negative value means no limit.
+	MaxFileBytes int `yaml:"max_file_bytes"`
+
+	// LiteOversize, if set, highlights only comments and strings in a
+	// body over its max_bytes or max_file_bytes limit instead of leaving
+	// it unstyled.
+	LiteOversize bool `yaml:"lite_oversize"`
+
+	// OnUnmatched chooses what happens to a window that no handler or
+	// shebang matches: "ignore" (the default) leaves it alone, "log"
+	// debug-logs its name and extension, which helps when building out
+	// the handler list, and "default" highlights it as DefaultLanguage.
+	OnUnmatched     string `yaml:"on_unmatched"`
+	DefaultLanguage string `yaml:"default_language"`
+
+	// MatchBrackets, if positive, highlights the bracket pair at the
+	// cursor with the bracket.match style, palette name b, which the
+	// acme-styles palette must define (see token_names.txt).  Acme does
+	// not report cursor movement, so each window's dot is polled this
+	// often (e.g. "250ms"); that is two 9P messages per window per poll.
+	// Zero, the default, disables it.
+	MatchBrackets time.Duration `yaml:"match_brackets"`
+
+	// CaptureFallback controls how a capture with no style of its own
+	// finds one.  "full", the default, drops trailing components until
+	// a styled name is found, so @string.special.symbol falls back to
+	// @string.special and then @string; "none" styles exact matches
+	// only; a number such as 1 allows dropping at most that many.
+	CaptureFallback string `yaml:"capture_fallback"`
+
+	// CapturePriority ranks captures for when two claim the same text.
+	// By default the pattern that comes first in the query file wins;
+	// with a table, the capture with the higher number wins, and ties,
+	// including captures not listed (priority 0), go by query order.
+	// Names fall back like styles, so "function" covers
+	// "function.method":
+	//
+	//	capture_priority:
+	//	  comment: 10
+	//	  string: 5
+	CapturePriority map[string]int `yaml:"capture_priority"`
+
+	// Todo, if set, picks out TodoMarkers inside comments with the
+	// comment.todo style, palette name x, which the acme-styles palette
+	// must define (see token_names.txt).  TodoMarkers defaults to
+	// DefaultTodoMarkers.
+	Todo        bool     `yaml:"todo"`
+	TodoMarkers []string `yaml:"todo_markers"`
+
+	// MergeGap, if positive, merges entries of the same style separated
+	// by at most that many unstyled runes into one, styling the gap as
+	// well.  Dense files then make far smaller layer writes, at the cost
+	// of coloring the odd space or comma.  Zero, the default, is off.
+	MergeGap int `yaml:"merge_gap"`
+
+	// HighlightErrors, if set, styles the text of ERROR nodes, and the
+	// spot of MISSING ones, with the error style, over any capture, so
+	// that the part of a file that fails to parse is visible.  Off by
+	// default.
+	HighlightErrors bool `yaml:"highlight_errors"`
+
+	// CaptureRules post-process highlight captures before they are
+	// styled, in order; the first rule that matches a capture decides.
+	CaptureRules []CaptureRule `yaml:"capture_rules"`
+}
+
+// CaptureRule drops or renames matching captures.  For example, to leave
+// variables unstyled in Go tests:
+//
+//	capture_rules:
+//	  - file: '_test\.go$'
+//	    capture: variable
+type CaptureRule struct {
+	// File, if set, is a regexp the window name must match.
+	File string `yaml:"file"`
+	// Capture is the capture name the rule applies to; it also matches
+	// its sub-captures, so "function" covers "function.method".
+	Capture string `yaml:"capture"`
+	// Text, if set, is a regexp the captured source text must match.
+	Text string `yaml:"text"`
+	// Remap is the capture name to style the text as instead; empty
+	// drops the capture.
+	Remap string `yaml:"remap"`
+}
+
+// DefaultTodoMarkers are the markers highlighted when todo is set and
+// todo_markers is not.
+var DefaultTodoMarkers = []string{"TODO", "FIXME", "XXX", "NOTE", "HACK"}
+
+// CaptureFallback keywords.
+const (
+	FallbackFull = "full"
+	FallbackNone = "none"
+)
+
+// FallbackLevels returns CaptureFallback as a number of components that
+// may be dropped: 0 for any number, -1 for none.
+func (c *Config) FallbackLevels() int {
+	switch c.CaptureFallback {
+	case FallbackFull, "":
+		return 0
+	case FallbackNone, "0":
+		return -1
+	}
+	n, _ := strconv.Atoi(c.CaptureFallback) // checked by validate
+	return n
+}
+
+// OnUnmatched values.
+const (
+	UnmatchedIgnore  = "ignore"
+	UnmatchedLog     = "log"
+	UnmatchedDefault = "default"
+)
+
+// setDefaults fills in unset tunables.
+func (c *Config) setDefaults() {
+	setDefault(&c.Debounce, 200*time.Millisecond)
+	setDefault(&c.RetryBase, 100*time.Millisecond)
+	setDefault(&c.RetryCap, 5*time.Second)
+	setDefault(&c.ReconnectBase, 200*time.Millisecond)
+	setDefault(&c.ReconnectCap, 30*time.Second)
+	if c.DetectBytes == 0 {
+		c.DetectBytes = 1024
+	}
+	if c.PreviewLines == 0 {
+		c.PreviewLines = 200
+	}
+	if c.MaxFileBytes == 0 {
+		c.MaxFileBytes = 2 << 20
+	}
+	if c.OnUnmatched == "" {
+		c.OnUnmatched = UnmatchedIgnore
+	}
+	if c.CaptureFallback == "" {
+		c.CaptureFallback = FallbackFull
+	}
+	if c.Todo && len(c.TodoMarkers) == 0 {
+		c.TodoMarkers = DefaultTodoMarkers
+	}
+}
+
+func setDefault(d *time.Duration, def time.Duration) {
+	if *d == 0 {
+		*d = def
+	}
+}
+
+// validate reports settings that cannot work.
+func (c *Config) validate() error {
+	for _, d := range []struct {
+		name string
+		v    time.Duration
+	}{
+		{"query_timeout", c.QueryTimeout},
+		{"reopen_cache", c.ReopenCache},
+		{"debounce", c.Debounce},
+		{"resync", c.Resync},
+		{"match_brackets", c.MatchBrackets},
+		{"retry_base", c.RetryBase},
+		{"retry_cap", c.RetryCap},
+		{"reconnect_base", c.ReconnectBase},
+		{"reconnect_cap", c.ReconnectCap},
+	} {
+		if d.v < 0 {
+			return fmt.Errorf("%s: must not be negative", d.name)
+		}
+	}
+	if c.RetryCap < c.RetryBase {
+		return fmt.Errorf("retry_cap (%v) is less than retry_base (%v)", c.RetryCap, c.RetryBase)
+	}
+	if c.ReconnectCap < c.ReconnectBase {
+		return fmt.Errorf("reconnect_cap (%v) is less than reconnect_base (%v)", c.ReconnectCap, c.ReconnectBase)
+	}
+	if c.DetectBytes < 0 {
+		return fmt.Errorf("detect_bytes: must not be negative")
+	}
+	if _, err := regexp.Compile(c.PreviewPattern); err != nil {
+		return fmt.Errorf("preview_pattern: %w", err)
+	}
+	if c.MergeGap < 0 {
+		return fmt.Errorf("merge_gap: must not be negative")
+	}
+	if c.PreviewLines < 0 {
+		return fmt.Errorf("preview_lines: must not be negative")
+	}
+	switch c.OnUnmatched {
+	case UnmatchedIgnore, UnmatchedLog:
+	case UnmatchedDefault:
+		if c.DefaultLanguage == "" {
+			return fmt.Errorf("on_unmatched: default requires default_language")
+		}
+	default:
+		return fmt.Errorf("on_unmatched: %q is not one of ignore, log, default", c.OnUnmatched)
+	}
+	switch c.CaptureFallback {
+	case FallbackFull, FallbackNone:
+	default:
+		if n, err := strconv.Atoi(c.CaptureFallback); err != nil || n < 0 {
+			return fmt.Errorf("capture_fallback: %q is not full, none or a level count", c.CaptureFallback)
+		}
+	}
+	for i, r := range c.CaptureRules {
+		if r.Capture == "" {
+			return fmt.Errorf("capture_rules[%d]: capture is required", i)
+		}
+	}
+	for id, d := range c.DebounceLanguages {
+		if d < 0 {
+			return fmt.Errorf("debounce_languages: %s: must not be negative", id)
+		}
+	}
+	for id, n := range c.MaxBytes {
+		if n < 0 {
+			return fmt.Errorf("max_bytes: %s: must not be negative", id)
+		}
+	}
+	return nil
+}
+
+// IsEnabled reports whether highlighting is enabled.  A missing enabled
+// field means enabled.
+func (c *Config) IsEnabled() bool {
+	return c.Enabled == nil || *c.Enabled
 }
 
 // FilenameHandler associates a filename regex pattern with a grammar language ID.
 type FilenameHandler struct {
-	Pattern    string `yaml:"pattern"`
-	LanguageID string `yaml:"language_id"`
+	Pattern    string      `yaml:"pattern"`
+	LanguageID LanguageIDs `yaml:"language_id"`
+}
+
+// LanguageIDs is a fallback chain of grammar language IDs; the first one
+// with a registered grammar is used.  In YAML it is either a single string
+// or a list:
+//
+//	language_id: go
+//	language_id: [typescript, javascript]
+type LanguageIDs []string
+
+// UnmarshalYAML accepts a scalar or a sequence of scalars.
+func (l *LanguageIDs) UnmarshalYAML(n *yaml.Node) error {
+	switch n.Kind {
+	case yaml.ScalarNode:
+		var id string
+		if err := n.Decode(&id); err != nil {
+			return err
+		}
+		*l = LanguageIDs{id}
+		return nil
+	case yaml.SequenceNode:
+		var ids []string
+		if err := n.Decode(&ids); err != nil {
+			return err
+		}
+		*l = ids
+		return nil
+	}
+	return fmt.Errorf("line %d: language_id must be a string or a list of strings", n.Line)
 }
 
-// Load reads path and returns the parsed Config.
+// Default is the default configuration, config/default.yaml, as shipped
+// with the binary.  See the -init flag.
+//
+//go:embed default.yaml
+var Default string
+
+// DefaultPath returns where the config lives by convention:
+// $HOME/lib/acme-treesitter/config.yaml.
+func DefaultPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, "lib", "acme-treesitter", "config.yaml"), nil
+}
+
+// Load reads path and returns the parsed Config.  A path of "-" reads the
+// config from standard input.
 func Load(path string) (*Config, error) {
-	data, err := os.ReadFile(path)
+	if path == "-" {
+		return Read(os.Stdin, "stdin")
+	}
+	f, err := os.Open(path)
 	if err != nil {
 		return nil, err
 	}
+	defer f.Close()
+	return Read(f, path)
+}
+
+// Read parses a YAML config from r, fills in defaults for unset tunables,
+// and validates it.  name identifies the source in error messages.
+func Read(r io.Reader, name string) (*Config, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", name, err)
+	}
 	var cfg Config
 	if err := yaml.Unmarshal(data, &cfg); err != nil {
-		return nil, fmt.Errorf("parse %s: %w", path, err)
+		return nil, fmt.Errorf("parse %s: %w", name, err)
+	}
+	cfg.setDefaults()
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
 	}
 	return &cfg, nil
 }
@@ -0,0 +1,117 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRead(t *testing.T) {
+	const src = `
+query_timeout: 50ms
+filename_handlers:
+  - pattern: \.go$
+    language_id: go
+`
+	cfg, err := Read(strings.NewReader(src), "test")
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if !cfg.IsEnabled() {
+		t.Error("IsEnabled() = false, want true when enabled is absent")
+	}
+	if cfg.QueryTimeout != 50*time.Millisecond {
+		t.Errorf("QueryTimeout = %v, want 50ms", cfg.QueryTimeout)
+	}
+	if len(cfg.FilenameHandlers) != 1 || !reflect.DeepEqual(cfg.FilenameHandlers[0].LanguageID, LanguageIDs{"go"}) {
+		t.Errorf("FilenameHandlers = %+v, want one go handler", cfg.FilenameHandlers)
+	}
+}
+
+func TestEnabled(t *testing.T) {
+	for src, want := range map[string]bool{
+		"debounce: 100ms\n": true, // enabled omitted
+		"enabled: true\n":   true,
+		"enabled: false\n":  false,
+	} {
+		cfg, err := Read(strings.NewReader(src), "test")
+		if err != nil {
+			t.Fatalf("Read(%q): %v", src, err)
+		}
+		if got := cfg.IsEnabled(); got != want {
+			t.Errorf("Read(%q).IsEnabled() = %v, want %v", src, got, want)
+		}
+	}
+}
+
+func TestReadInvalid(t *testing.T) {
+	_, err := Read(strings.NewReader("filename_handlers: {"), "bad.yaml")
+	if err == nil || !strings.Contains(err.Error(), "bad.yaml") {
+		t.Errorf("Read(invalid) error = %v, want parse error naming bad.yaml", err)
+	}
+}
+
+func TestLanguageIDList(t *testing.T) {
+	const src = `
+filename_handlers:
+  - pattern: \.tsx?$
+    language_id: [typescript, javascript]
+`
+	cfg, err := Read(strings.NewReader(src), "test")
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	want := LanguageIDs{"typescript", "javascript"}
+	if got := cfg.FilenameHandlers[0].LanguageID; !reflect.DeepEqual(got, want) {
+		t.Errorf("LanguageID = %q, want %q", got, want)
+	}
+}
+
+func TestReadDefaults(t *testing.T) {
+	cfg, err := Read(strings.NewReader("retry_cap: 10s\n"), "test")
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if cfg.Debounce != 200*time.Millisecond || cfg.RetryBase != 100*time.Millisecond ||
+		cfg.RetryCap != 10*time.Second || cfg.ReconnectCap != 30*time.Second || cfg.DetectBytes != 1024 ||
+		cfg.MaxFileBytes != 2<<20 {
+		t.Errorf("defaults not applied: %+v", cfg)
+	}
+}
+
+func TestReadValidate(t *testing.T) {
+	for _, src := range []string{
+		"retry_base: 1s\nretry_cap: 500ms\n",
+		"reconnect_cap: 100ms\n", // below the 200ms default base
+		"debounce: -1s\n",
+		"debounce_languages: {cpp: -1s}\n",
+		"on_unmatched: guess\n",
+		"capture_fallback: some\n",
+		"capture_fallback: -1\n",
+		"on_unmatched: default\n", // no default_language
+		"preview_pattern: '(unclosed'\n",
+	} {
+		if _, err := Read(strings.NewReader(src), "test"); err == nil {
+			t.Errorf("Read(%q) succeeded, want validation error", src)
+		}
+	}
+}
+
+func TestFallbackLevels(t *testing.T) {
+	for src, want := range map[string]int{
+		"":                         0,
+		"capture_fallback: full\n": 0,
+		"capture_fallback: none\n": -1,
+		"capture_fallback: 0\n":    -1,
+		"capture_fallback: 2\n":    2,
+	} {
+		cfg, err := Read(strings.NewReader(src), "test")
+		if err != nil {
+			t.Fatalf("Read(%q): %v", src, err)
+		}
+		if got := cfg.FallbackLevels(); got != want {
+			t.Errorf("Read(%q).FallbackLevels() = %d, want %d", src, got, want)
+		}
+	}
+}
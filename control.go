@@ -0,0 +1,58 @@
+package treesitter
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"9fans.net/go/plan9/client"
+)
+
+// LanguageOff is the LangRequest language that turns highlighting off.
+const LanguageOff = "off"
+
+// ControlSocket returns the path of the unix socket on which acme-treesitter
+// accepts requests from the Ts command: acme-treesitter in the plan9port
+// namespace directory, next to acme's own.
+func ControlSocket() string {
+	return filepath.Join(client.Namespace(), "acme-treesitter")
+}
+
+// LangRequest asks for window ID, named Name, to be highlighted as
+// Language regardless of its filename, or not at all if Language is
+// LanguageOff.  On the control socket it is one line:
+//
+//	lang <id> <language> <name>
+type LangRequest struct {
+	ID       int
+	Language string
+	Name     string // may contain spaces, or be empty for a scratch window
+}
+
+func (r LangRequest) String() string {
+	return fmt.Sprintf("lang %d %s %s", r.ID, r.Language, r.Name)
+}
+
+// ParseLangRequest parses the line form of a LangRequest, without its
+// trailing newline.
+func ParseLangRequest(line string) (LangRequest, error) {
+	f := strings.SplitN(line, " ", 4)
+	if len(f) < 3 || f[0] != "lang" {
+		return LangRequest{}, fmt.Errorf("malformed request %q", line)
+	}
+	id, err := strconv.Atoi(f[1])
+	if err != nil {
+		return LangRequest{}, fmt.Errorf("window id %q: %w", f[1], err)
+	}
+	r := LangRequest{ID: id, Language: f[2]}
+	if len(f) == 4 {
+		r.Name = f[3]
+	}
+	return r, nil
+}
+
+// HasLanguage reports whether id names a registered language.
+func HasLanguage(id string) bool {
+	return langByID(id) != nil
+}
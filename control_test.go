@@ -0,0 +1,21 @@
+package treesitter
+
+import "testing"
+
+func TestLangRequestRoundTrip(t *testing.T) {
+	for _, r := range []LangRequest{
+		{ID: 7, Language: "python", Name: "/src/my notes"},
+		{ID: 12, Language: LanguageOff, Name: "/src/a.go"},
+		{ID: 3, Language: "go"}, // scratch window
+	} {
+		got, err := ParseLangRequest(r.String())
+		if err != nil || got != r {
+			t.Errorf("ParseLangRequest(%q) = %+v, %v; want %+v", r.String(), got, err, r)
+		}
+	}
+	for _, bad := range []string{"", "lang", "lang 7", "lang x go /a", "open 7 go /a"} {
+		if _, err := ParseLangRequest(bad); err == nil {
+			t.Errorf("ParseLangRequest(%q) succeeded, want error", bad)
+		}
+	}
+}
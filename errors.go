@@ -0,0 +1,41 @@
+package treesitter
+
+import tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+// markErrors restyles the ERROR and MISSING nodes of tree that overlap
+// bytes [lo, hi) as idx, over whatever captures claimed them, so that a
+// region that does not parse stands out.  A MISSING node is empty; the
+// byte it would precede is marked instead, or the last byte at the end
+// of the text.  Only subtrees that contain errors are walked.
+func markErrors(tree *tree_sitter.Tree, stylePerByte []byte, lo, hi, idx int) {
+	root := tree.RootNode()
+	if idx == 0 || !root.HasError() {
+		return
+	}
+	c := root.Walk()
+	defer c.Close()
+	for {
+		n := c.Node()
+		start, end := int(n.StartByte()), int(n.EndByte())
+		switch {
+		case end < lo || start > hi || !n.HasError():
+		case n.IsError() || n.IsMissing():
+			if start == end {
+				if start == len(stylePerByte) {
+					start--
+				}
+				end = start + 1
+			}
+			for i := max(start, lo, 0); i < min(end, hi); i++ {
+				stylePerByte[i] = byte(idx)
+			}
+		case c.GotoFirstChild():
+			continue
+		}
+		for !c.GotoNextSibling() {
+			if !c.GotoParent() {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package treesitter
+
+import "testing"
+
+func TestMarkErrors(t *testing.T) {
+	errStyle := CaptureStyle("error")
+	countErrors := func(src string, tw tweaks) int {
+		entries, _ := computeHighlights(langByID("go"), []byte(src), tw, 0)
+		n := 0
+		for _, e := range entries {
+			if e.Name == errStyle {
+				n++
+			}
+		}
+		return n
+	}
+	const good = "package a\n\nfunc f() {}\n"
+	const broken = "package a\n\nfunc f() {\n\tx := \n"
+	if n := countErrors(good, tweaks{errors: true}); n != 0 {
+		t.Errorf("valid source: %d error entries, want 0", n)
+	}
+	if n := countErrors(broken, tweaks{errors: true}); n == 0 {
+		t.Error("broken source: no error entries")
+	}
+	if n := countErrors(broken, tweaks{}); n != 0 {
+		t.Errorf("broken source with highlight_errors off: %d error entries, want 0", n)
+	}
+}
@@ -0,0 +1,89 @@
+package treesitter
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// foldQueries holds the optional per-language fold queries, named
+// queries/folds/<language_id>.scm.  Every capture in a fold query marks a
+// foldable node.
+//
+//go:embed queries/folds
+var foldQueries embed.FS
+
+// foldRange is a foldable span of lines, 1-based and inclusive.
+type foldRange struct {
+	start, end int
+}
+
+// computeFolds parses src and returns the multi-line ranges captured by
+// lang's fold query, sorted by start line.  It returns nil if lang has no
+// fold query.
+func computeFolds(lang *Language, src []byte) []foldRange {
+	if lang == nil || lang.folds == nil || len(src) == 0 {
+		return nil
+	}
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(lang.lang)
+	tree := parser.Parse(src, nil)
+	defer tree.Close()
+
+	qc := tree_sitter.NewQueryCursor()
+	defer qc.Close()
+
+	seen := make(map[foldRange]bool)
+	var folds []foldRange
+	captures := qc.Captures(lang.folds, tree.RootNode(), src)
+	for match, captureIdx := captures.Next(); match != nil; match, captureIdx = captures.Next() {
+		node := match.Captures[captureIdx].Node
+		r := foldRange{
+			start: int(node.StartPosition().Row) + 1,
+			end:   int(node.EndPosition().Row) + 1,
+		}
+		if r.end > r.start && !seen[r] {
+			seen[r] = true
+			folds = append(folds, r)
+		}
+	}
+	sort.Slice(folds, func(i, j int) bool {
+		if folds[i].start != folds[j].start {
+			return folds[i].start < folds[j].start
+		}
+		return folds[i].end > folds[j].end
+	})
+	return folds
+}
+
+// formatFolds renders folds one per line as acme line addresses, "12,30".
+func formatFolds(folds []foldRange) []byte {
+	var b bytes.Buffer
+	for _, f := range folds {
+		fmt.Fprintf(&b, "%d,%d\n", f.start, f.end)
+	}
+	return b.Bytes()
+}
+
+// writeFolds publishes folds for window id as dir/<id>, replacing the file
+// atomically so readers never see a partial list.
+func writeFolds(dir string, id int, folds []foldRange) error {
+	path := filepath.Join(dir, strconv.Itoa(id))
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, formatFolds(folds), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// removeFolds deletes the fold file for window id, if any.
+func removeFolds(dir string, id int) {
+	os.Remove(filepath.Join(dir, strconv.Itoa(id)))
+}
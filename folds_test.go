@@ -0,0 +1,49 @@
+package treesitter
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestComputeFolds(t *testing.T) {
+	src := []byte(`package p
+
+import (
+	"fmt"
+	"os"
+)
+
+func f() {
+	if true {
+		fmt.Println(os.Args)
+	}
+}
+
+func g() {}
+`)
+	got := computeFolds(langByID("go"), src)
+	want := []foldRange{{3, 6}, {8, 12}, {9, 11}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("computeFolds = %v, want %v", got, want)
+	}
+}
+
+func TestWriteFolds(t *testing.T) {
+	dir := t.TempDir()
+	if err := writeFolds(dir, 7, []foldRange{{3, 6}, {8, 12}}); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "7"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(data), "3,6\n8,12\n"; got != want {
+		t.Errorf("fold file = %q, want %q", got, want)
+	}
+	removeFolds(dir, 7)
+	if _, err := os.Stat(filepath.Join(dir, "7")); !os.IsNotExist(err) {
+		t.Errorf("fold file still present after removeFolds: %v", err)
+	}
+}
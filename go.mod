@@ -4,16 +4,31 @@ go 1.24.4
 
 require (
 	9fans.net/go v0.0.7
+	github.com/Beaglefoot/tree-sitter-awk v0.7.2
+	github.com/JoranHonig/tree-sitter-solidity v1.2.11
 	github.com/cptaffe/acme-styles v0.0.0-20260220164436-7a3822fafbca
+	github.com/eonpatapon/tree-sitter-cue v0.1.0
+	github.com/sogaiu/tree-sitter-clojure v0.0.13
+	github.com/tree-sitter-grammars/tree-sitter-markdown v0.5.1
+	github.com/tree-sitter-grammars/tree-sitter-objc v1.1.0
+	github.com/tree-sitter-grammars/tree-sitter-svelte v1.0.2
+	github.com/tree-sitter-grammars/tree-sitter-vim v0.5.0
 	github.com/tree-sitter/go-tree-sitter v0.25.0
 	github.com/tree-sitter/tree-sitter-bash v0.25.1
 	github.com/tree-sitter/tree-sitter-c v0.24.1
+	github.com/tree-sitter/tree-sitter-cpp v0.23.4
 	github.com/tree-sitter/tree-sitter-go v0.25.0
+	github.com/tree-sitter/tree-sitter-html v0.23.2
 	github.com/tree-sitter/tree-sitter-java v0.23.5
 	github.com/tree-sitter/tree-sitter-javascript v0.25.0
+	github.com/tree-sitter/tree-sitter-json v0.24.8
 	github.com/tree-sitter/tree-sitter-python v0.25.0
+	github.com/tree-sitter/tree-sitter-racket v0.24.7
 	github.com/tree-sitter/tree-sitter-rust v0.24.0
 	github.com/tree-sitter/tree-sitter-scala v0.24.0
+	github.com/tree-sitter/tree-sitter-scheme v0.24.7
+	github.com/tree-sitter/tree-sitter-typescript v0.23.2
+	github.com/tree-sitter/tree-sitter-verilog v1.0.3
 	go.uber.org/zap v1.27.1
 	gopkg.in/yaml.v3 v3.0.1
 )
@@ -22,3 +37,24 @@ require (
 	github.com/mattn/go-pointer v0.0.1 // indirect
 	go.uber.org/multierr v1.10.0 // indirect
 )
+
+// The releases of these grammars ship no Go bindings.  third_party holds
+// each one's generated parser at the required version, with a bindings/go
+// package added.
+replace (
+	github.com/Beaglefoot/tree-sitter-awk => ./third_party/tree-sitter-awk
+	github.com/eonpatapon/tree-sitter-cue => ./third_party/tree-sitter-cue
+	github.com/JoranHonig/tree-sitter-solidity => ./third_party/tree-sitter-solidity
+	github.com/sogaiu/tree-sitter-clojure => ./third_party/tree-sitter-clojure
+	github.com/tree-sitter-grammars/tree-sitter-objc => ./third_party/tree-sitter-objc
+	github.com/tree-sitter-grammars/tree-sitter-vim => ./third_party/tree-sitter-vim
+)
+
+// 6cdh's racket and scheme modules declare the tree-sitter organization's
+// paths, under which nothing is published.  The racket release's
+// bindings/go also leaves out its external scanner, so it is vendored
+// like those above.
+replace (
+	github.com/tree-sitter/tree-sitter-racket => ./third_party/tree-sitter-racket
+	github.com/tree-sitter/tree-sitter-scheme => github.com/6cdh/tree-sitter-scheme v0.24.7
+)
@@ -1,10 +1,34 @@
 package treesitter
 
 import (
+	"fmt"
+	"sort"
+	"time"
+
 	"github.com/cptaffe/acme-styles/layer"
 	tree_sitter "github.com/tree-sitter/go-tree-sitter"
 )
 
+// tweaks are the per-window adjustments made on top of a language's query.
+type tweaks struct {
+	filter captureFilter // from capture_rules; nil if none apply
+	todo   [][]byte      // markers restyled as @comment.todo in comments
+
+	fallback int // see lookupCaptureIdxFallback; 0 is unlimited
+
+	// priority, if non-nil, ranks captures by name (see capturePriority);
+	// a higher-ranked capture takes bytes from a lower-ranked one.
+	priority map[string]int
+
+	mergeGap int // see mergeEntries; 0 is off
+
+	errors bool // style syntax errors as @error (see markErrors)
+
+	// styles, if non-nil, replaces the token_names.txt palette, for
+	// Highlight.  todo markers are not picked out with it.
+	styles *styleTable
+}
+
 // computeHighlights parses src with lang's grammar, runs the highlight query,
 // and returns a slice of layer.Entry values (rune-offset based) ready for an
 // acme-styles layer.
@@ -12,30 +36,107 @@ import (
 // "First capture wins": for a given byte position, whichever pattern appears
 // earliest in the query file claims that position.  Later catch-all patterns
 // (e.g. @variable) therefore do not overwrite specific ones (e.g. @function).
-func computeHighlights(lang *Language, src []byte) []layer.Entry {
-	if lang == nil || lang.query == nil || len(src) == 0 {
-		return nil
+//
+// tw adjusts the result for the window: its filter is consulted for every
+// capture and may drop or rename it, and its todo markers are picked out
+// inside comments.
+//
+// If budget is positive, capture iteration stops once it has been exceeded
+// and the entries collected so far are returned with truncated set.  Captures
+// arrive in document order, so the styled part is the start of the file.
+func computeHighlights(lang *Language, src []byte, tw tweaks, budget time.Duration) (entries []layer.Entry, truncated bool) {
+	if lang == nil {
+		return nil, false
+	}
+	return runHighlightQuery(lang, false, src, tw, budget)
+}
+
+// computeLiteHighlights is computeHighlights restricted to comments and
+// strings, for bodies too large to highlight in full.  Injected languages
+// are not highlighted.
+func computeLiteHighlights(lang *Language, src []byte, tw tweaks, budget time.Duration) (entries []layer.Entry, truncated bool) {
+	if lang == nil {
+		return nil, false
 	}
+	return runHighlightQuery(lang, true, src, tw, budget)
+}
 
-	// Each goroutine needs its own Parser and QueryCursor.
+// parse parses src with lang's grammar.  If old is non-nil it must have
+// been edited to match src (see replayEdits), and only the parts of it
+// the edits touched are parsed again.
+func parse(lang *Language, src []byte, old *tree_sitter.Tree) *tree_sitter.Tree {
+	// Each goroutine needs its own Parser.
 	parser := tree_sitter.NewParser()
 	defer parser.Close()
 	parser.SetLanguage(lang.lang)
+	return parser.Parse(src, old)
+}
 
-	tree := parser.Parse(src, nil)
+// runHighlightQuery does the work of computeHighlights, with lang's lite
+// query if lite is set.  The full query is followed by lang's injections.
+func runHighlightQuery(lang *Language, lite bool, src []byte, tw tweaks, budget time.Duration) (entries []layer.Entry, truncated bool) {
+	q := lang.query
+	if lite {
+		q = lang.lite
+	}
+	if q == nil || len(src) == 0 {
+		return nil, false
+	}
+	tree := parse(lang, src, nil)
 	defer tree.Close()
+	styles, truncated := styleTree(q, tree, src, tw, budget)
+	if !lite && !truncated {
+		truncated = claimInjections(lang, tree, src, tw, budget, styles, 0, len(src))
+	}
+	return styleEntries(styles, src, tw), truncated
+}
 
+// styleTree runs query q over tree, parsed from src, and returns the style
+// of each byte of src: stylePerByte[i] is the palette index (≥1) of byte
+// i, or 0 if it is unclaimed.  Bytes are single palette indices; the
+// palettes have a dozen or so entries.
+func styleTree(q *tree_sitter.Query, tree *tree_sitter.Tree, src []byte, tw tweaks, budget time.Duration) (stylePerByte []byte, truncated bool) {
+	stylePerByte = make([]byte, len(src))
+	truncated = claimCaptures(q, tree, src, tw, budget, stylePerByte, 0, len(src))
+	return stylePerByte, truncated
+}
+
+// claimCaptures runs query q over the nodes of tree that overlap bytes
+// [lo, hi) of src and claims each capture's bytes within that range in
+// stylePerByte, which must be unclaimed there.  Captures arrive in the
+// same order whatever the range, so restyling part of a body gives what
+// styling all of it would have there.
+func claimCaptures(q *tree_sitter.Query, tree *tree_sitter.Tree, src []byte, tw tweaks, budget time.Duration, stylePerByte []byte, lo, hi int) (truncated bool) {
+	// Each goroutine needs its own QueryCursor.
 	qc := tree_sitter.NewQueryCursor()
 	defer qc.Close()
+	if lo > 0 || hi < len(src) {
+		qc.SetByteRange(uint(lo), uint(hi))
+	}
 
-	// stylePerByte[i] = canonicalTable index (≥1) for byte i; 0 = unclaimed.
-	// We use uint8 — canonicalTable has ≤ 10 entries.
-	stylePerByte := make([]byte, len(src))
+	// prioPerByte[i] is the priority of the capture that claimed byte i,
+	// when a priority table is in use.
+	var prioPerByte []int
+	if tw.priority != nil {
+		prioPerByte = make([]int, len(src))
+	}
 
-	captureNames := lang.query.CaptureNames()
-	captures := qc.Captures(lang.query, tree.RootNode(), src)
+	index, _ := tw.palette()
+	captureNames := q.CaptureNames()
+	captures := qc.Captures(q, tree.RootNode(), src)
 
+	var deadline time.Time
+	if budget > 0 {
+		deadline = time.Now().Add(budget)
+	}
+	n := 0
 	for match, captureIdx := captures.Next(); match != nil; match, captureIdx = captures.Next() {
+		// Checking the clock on every capture is measurable on big files;
+		// every 256 captures is plenty fine-grained.
+		n++
+		if !deadline.IsZero() && n%256 == 0 && time.Now().After(deadline) {
+			return true
+		}
 		if int(captureIdx) >= len(match.Captures) {
 			continue
 		}
@@ -44,14 +145,137 @@ func computeHighlights(lang *Language, src []byte) []layer.Entry {
 			continue
 		}
 		capName := captureNames[cap.Index]
-		idx := lookupCaptureIdx(capName)
+		start := int(cap.Node.StartByte())
+		end := int(cap.Node.EndByte())
+		if tw.filter != nil {
+			var keep bool
+			if capName, keep = tw.filter(capName, src[start:end]); !keep {
+				continue
+			}
+		}
+		idx := lookupIdx(index, capName, tw.fallback)
 		if idx == 0 {
 			continue
 		}
-		start := int(cap.Node.StartByte())
-		end := int(cap.Node.EndByte())
-		applyCapture(stylePerByte, start, end, idx)
+		start, end = max(start, lo), min(end, hi)
+		if prioPerByte != nil {
+			applyCapturePriority(stylePerByte, prioPerByte, start, end, idx, capturePriority(tw.priority, capName))
+		} else {
+			applyCapture(stylePerByte, start, end, idx)
+		}
+	}
+	if tw.errors {
+		markErrors(tree, stylePerByte, lo, hi, lookupIdx(index, "error", tw.fallback))
+	}
+	return false
+}
+
+// styleEntries converts the byte styles of src to entries, picking out
+// todo markers first.
+func styleEntries(stylePerByte, src []byte, tw tweaks) []layer.Entry {
+	// Nothing claimed: skip the compress pass, which would walk every rune
+	// of src only to emit no entries.
+	if allStyled(stylePerByte, 0) {
+		return nil
+	}
+	if len(tw.todo) > 0 && tw.styles == nil {
+		markTodos(stylePerByte, src, tw.todo)
+	}
+	_, names := tw.palette()
+	entries := compressToEntriesNamed(stylePerByte, src, runeCoords, names)
+	if tw.mergeGap > 0 {
+		entries = mergeEntries(entries, tw.mergeGap)
 	}
+	return entries
+}
+
+// palette returns the capture index and style names tw styles with.
+func (tw tweaks) palette() (map[string]int, []string) {
+	if tw.styles != nil {
+		return tw.styles.index, tw.styles.names
+	}
+	return canonicalIndex, canonicalTable
+}
 
-	return compressToEntries(stylePerByte, src)
+// mergeEntries merges runs of same-style entries separated by at most gap
+// unstyled runes, in place, and returns the shortened slice.
+func mergeEntries(entries []layer.Entry, gap int) []layer.Entry {
+	if len(entries) == 0 {
+		return entries
+	}
+	out := entries[:1]
+	for _, e := range entries[1:] {
+		last := &out[len(out)-1]
+		if e.Name == last.Name && e.Start-last.End <= gap {
+			last.End = e.End
+			continue
+		}
+		out = append(out, e)
+	}
+	return out
+}
+
+// StyleMap assigns style names to captures, for library users of
+// Highlight who bring their own palette.  Keys are capture names without
+// the @, and apply to sub-captures too unless those have keys of their
+// own: "function" covers "function.method".  A capture that resolves to
+// no key, or to "", is transparent: left unstyled.
+type StyleMap map[string]string
+
+// styleTable is a palette in the form runHighlightQuery uses: names[i] is
+// the style of index i, names[0] being "" for unstyled, and index maps
+// capture names to indices.
+type styleTable struct {
+	names []string
+	index map[string]int
+}
+
+// table converts m to a styleTable.  Style indices are bytes, so m may
+// use at most 255 distinct names.
+func (m StyleMap) table() (*styleTable, error) {
+	t := &styleTable{names: []string{""}, index: make(map[string]int, len(m))}
+	byName := make(map[string]int)
+	captures := make([]string, 0, len(m))
+	for c := range m {
+		captures = append(captures, c)
+	}
+	sort.Strings(captures) // for stable indices
+	for _, c := range captures {
+		name := m[c]
+		if name == "" {
+			t.index[c] = 0 // stops fallback to a parent's style
+			continue
+		}
+		idx, ok := byName[name]
+		if !ok {
+			if len(t.names) > 255 {
+				return nil, fmt.Errorf("style map has more than 255 style names")
+			}
+			idx = len(t.names)
+			t.names = append(t.names, name)
+			byName[name] = idx
+		}
+		t.index[c] = idx
+	}
+	return t, nil
+}
+
+// Highlight parses src as language id and returns its highlight entries,
+// in rune offsets, with entry names taken from m.  A nil m uses the
+// palette names of token_names.txt, as the acme windows do.  It is the
+// entry point for using the highlighter as a library.
+func Highlight(id string, src []byte, m StyleMap) ([]layer.Entry, error) {
+	lang := langByID(id)
+	if lang == nil {
+		return nil, fmt.Errorf("unknown language %q", id)
+	}
+	var tw tweaks
+	if m != nil {
+		var err error
+		if tw.styles, err = m.table(); err != nil {
+			return nil, err
+		}
+	}
+	entries, _ := computeHighlights(lang, src, tw, 0)
+	return entries, nil
 }
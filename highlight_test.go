@@ -0,0 +1,332 @@
+package treesitter
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cptaffe/acme-styles/layer"
+	"github.com/cptaffe/acme-treesitter/config"
+)
+
+func TestComputeHighlightsUnstyled(t *testing.T) {
+	src := bytes.Repeat([]byte("\n"), 1024)
+	entries, truncated := computeHighlights(langByID("go"), src, tweaks{}, 0)
+	if entries != nil || truncated {
+		t.Errorf("computeHighlights(blank) = %v, %v; want nil, false", entries, truncated)
+	}
+}
+
+func TestCaptureRules(t *testing.T) {
+	rules, err := CompileCaptureRules(&config.Config{CaptureRules: []config.CaptureRule{
+		{File: `_test\.go$`, Capture: "comment", Text: `^// want`},
+		{File: `_test\.go$`, Capture: "string", Remap: "comment"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f := captureFilterFor(rules, "/src/a.go"); f != nil {
+		t.Error("captureFilterFor(a.go) is non-nil; no rule applies")
+	}
+
+	src := []byte("package a\n\n// want x\n// keep\nvar s = \"x\"\n")
+	got, _ := computeHighlights(langByID("go"), src, tweaks{filter: captureFilterFor(rules, "/src/a_test.go")}, 0)
+	comment := canonicalTable[lookupCaptureIdx("comment")]
+	var comments []string
+	for _, e := range got {
+		if e.Name == comment {
+			comments = append(comments, string(src[e.Start:e.End]))
+		}
+	}
+	if want := []string{"// keep", `"x"`}; !reflect.DeepEqual(comments, want) {
+		t.Errorf("comment-styled text = %q, want %q", comments, want)
+	}
+}
+
+func TestLookupCaptureFallback(t *testing.T) {
+	str := lookupCaptureIdx("string")
+	cases := []struct {
+		capture  string
+		fallback int
+		want     int
+	}{
+		{"string.special.symbol", 0, str}, // full
+		{"string.special.symbol", -1, 0},  // none
+		{"string", -1, str},               // exact match needs no fallback
+		{"string.special.symbol", 1, 0},   // string.special is unstyled
+		{"string.special.symbol", 2, str}, // two levels reach string
+		{"string.special", 1, str},        // one level is enough
+	}
+	for _, c := range cases {
+		if got := lookupCaptureIdxFallback(c.capture, c.fallback); got != c.want {
+			t.Errorf("lookupCaptureIdxFallback(%q, %d) = %d, want %d", c.capture, c.fallback, got, c.want)
+		}
+	}
+}
+
+func TestApplyCapturePriority(t *testing.T) {
+	table := map[string]int{"string": 5, "comment": 1}
+	if p := capturePriority(table, "string.special"); p != 5 {
+		t.Errorf("capturePriority(string.special) = %d, want 5 via string", p)
+	}
+	if p := capturePriority(table, "keyword"); p != 0 {
+		t.Errorf("capturePriority(keyword) = %d, want 0", p)
+	}
+
+	str, com, kw := lookupCaptureIdx("string"), lookupCaptureIdx("comment"), lookupCaptureIdx("keyword")
+	styles := make([]byte, 6)
+	prios := make([]int, 6)
+	apply := func(start, end, idx int, name string) {
+		applyCapturePriority(styles, prios, start, end, idx, capturePriority(table, name))
+	}
+	apply(0, 4, com, "comment") // first in query order
+	apply(2, 6, str, "string")  // outranks the comment
+	apply(0, 6, kw, "keyword")  // outranked everywhere but unclaimed bytes
+	want := []byte{byte(com), byte(com), byte(str), byte(str), byte(str), byte(str)}
+	if !bytes.Equal(styles, want) {
+		t.Errorf("styles = %v, want %v", styles, want)
+	}
+
+	// string.x ties with string, so the earlier claim stands.
+	apply(2, 3, com, "string.x")
+	if styles[2] != byte(str) {
+		t.Errorf("a tie replaced the earlier claim: styles[2] = %d, want %d", styles[2], str)
+	}
+}
+
+func TestMergeEntries(t *testing.T) {
+	entries := []layer.Entry{
+		{Name: "n", Start: 0, End: 1},
+		{Name: "n", Start: 2, End: 3},
+		{Name: "n", Start: 5, End: 6},
+		{Name: "s", Start: 6, End: 8},
+		{Name: "n", Start: 8, End: 9},
+	}
+	want := []layer.Entry{
+		{Name: "n", Start: 0, End: 3},
+		{Name: "n", Start: 5, End: 6},
+		{Name: "s", Start: 6, End: 8},
+		{Name: "n", Start: 8, End: 9},
+	}
+	if got := mergeEntries(entries, 1); !reflect.DeepEqual(got, want) {
+		t.Errorf("mergeEntries(gap 1) =\n%v\nwant\n%v", got, want)
+	}
+
+	src := []byte("package a\n\nvar x = []int{1, 2, 3, 4, 5, 6, 7, 8}\n")
+	lang := langByID("go")
+	full, _ := computeHighlights(lang, src, tweaks{}, 0)
+	merged, _ := computeHighlights(lang, src, tweaks{mergeGap: 2}, 0)
+	if len(merged) >= len(full)-6 {
+		t.Errorf("merge_gap 2 left %d of %d entries, want the 8 numbers merged into 1", len(merged), len(full))
+	}
+}
+
+func TestHighlightStyleMap(t *testing.T) {
+	src := []byte("package a\n\n// F is f.\nfunc F() { g(); x.m() }\n")
+	m := StyleMap{"comment": "grey", "function": "blue", "function.method": ""}
+	entries, err := Highlight("go", src, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, e := range entries {
+		got = append(got, e.Name+" "+string(src[e.Start:e.End]))
+	}
+	want := []string{"grey // F is f.", "blue F", "blue g"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Highlight with a style map:\n got %q\nwant %q", got, want)
+	}
+	if _, err := Highlight("no-such-lang", src, nil); err == nil {
+		t.Error("Highlight(no-such-lang) succeeded, want error")
+	}
+}
+
+func TestStyleSummary(t *testing.T) {
+	c, f := CaptureStyle("comment"), CaptureStyle("function")
+	entries := []layer.Entry{{Name: f}, {Name: c}, {Name: f}, {Name: "unknown"}}
+	if got, want := styleSummary(entries), "comment=1 function=2 unknown=1"; got != want {
+		t.Errorf("styleSummary = %q, want %q", got, want)
+	}
+}
+
+func TestCompressToEntriesCoords(t *testing.T) {
+	// "é" is two bytes, so the comment starts at rune 5 but byte 6.
+	src := []byte("x\u00e9 = //c")
+	stylePerByte := make([]byte, len(src))
+	applyCapture(stylePerByte, 6, len(src), lookupCaptureIdx("comment"))
+
+	got := compressToEntries(stylePerByte, src, runeCoords)
+	if want := []layer.Entry{{Name: "c", Start: 5, End: 8}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("runeCoords = %+v, want %+v", got, want)
+	}
+	got = compressToEntries(stylePerByte, src, byteCoords)
+	if want := []layer.Entry{{Name: "c", Start: 6, End: 9}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("byteCoords = %+v, want %+v", got, want)
+	}
+}
+
+// BenchmarkComputeHighlightsUnstyled measures a large buffer that parses
+// but produces no captures, the case served by the no-capture fast path.
+func BenchmarkComputeHighlightsUnstyled(b *testing.B) {
+	src := bytes.Repeat([]byte("\n"), 1<<20)
+	lang := langByID("go")
+	b.SetBytes(int64(len(src)))
+	for i := 0; i < b.N; i++ {
+		computeHighlights(lang, src, tweaks{}, 0)
+	}
+}
+
+// BenchmarkCompressUnstyled is the cost the fast path avoids.
+func BenchmarkCompressUnstyled(b *testing.B) {
+	src := bytes.Repeat([]byte("\n"), 1<<20)
+	stylePerByte := make([]byte, len(src))
+	b.SetBytes(int64(len(src)))
+	for i := 0; i < b.N; i++ {
+		compressToEntries(stylePerByte, src, runeCoords)
+	}
+}
+
+func TestGoDirectives(t *testing.T) {
+	src := []byte(`//go:build linux && !cgo
+// +build linux,!cgo
+
+// Package p is built without cgo.
+package p
+
+//go:generate stringer -type=Kind
+type Kind int
+
+//export Hook
+func Hook() {} // go:noinline is not a directive
+`)
+	entries, _ := computeHighlights(langByID("go"), src, tweaks{}, 0)
+	var got []string
+	for _, e := range entries {
+		if e.Name == CaptureStyle("comment.directive") {
+			got = append(got, string(src[e.Start:e.End]))
+		}
+	}
+	want := []string{
+		"//go:build linux && !cgo",
+		"// +build linux,!cgo",
+		"//go:generate stringer -type=Kind",
+		"//export Hook",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("go directives:\n got %q\nwant %q", got, want)
+	}
+}
+
+func TestBashCommandSubstitution(t *testing.T) {
+	src := []byte("echo \"in $(basename \"$(pwd)\")\" `date`\n")
+	entries, _ := computeHighlights(langByID("bash"), src, tweaks{}, 0)
+	var got []string
+	for _, e := range entries {
+		got = append(got, e.Name+" "+string(src[e.Start:e.End]))
+	}
+	f, s, o := CaptureStyle("function"), CaptureStyle("string"), CaptureStyle("operator")
+	want := []string{
+		f + " echo",
+		s + ` "in `,
+		o + " $(",
+		f + " basename",
+		s + ` "`,
+		o + " $(",
+		f + " pwd",
+		o + " )",
+		s + ` "`,
+		o + " )",
+		s + ` "`,
+		o + " `",
+		f + " date",
+		o + " `",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("bash highlights:\n got %q\nwant %q", got, want)
+	}
+}
+
+// TestCppSyntax checks that C++-only constructs, which the C grammar
+// cannot parse, are styled by the cpp grammar.
+func TestCppSyntax(t *testing.T) {
+	src := []byte("template <typename T>\nclass Box : public std::vector<T> {};\n")
+	entries, _ := computeHighlights(langByID("cpp"), src, tweaks{}, 0)
+	got := make(map[string]string)
+	for _, e := range entries {
+		got[string(src[e.Start:e.End])] = e.Name
+	}
+	k, ty, o := CaptureStyle("keyword"), CaptureStyle("type"), CaptureStyle("operator")
+	for text, want := range map[string]string{
+		"template": k,
+		"typename": k,
+		"class":    k,
+		"public":   k,
+		"Box":      ty,
+		"std":      ty,
+		"::":       o,
+		"vector":   ty,
+	} {
+		if got[text] != want {
+			t.Errorf("cpp style of %q = %q, want %q", text, got[text], want)
+		}
+	}
+}
+
+// TestScalaShebangScript checks that a scala script found by its shebang
+// highlights keywords, and that deeply nested expressions are styled
+// without the capture walk recursing.
+func TestScalaShebangScript(t *testing.T) {
+	const depth = 2000
+	src := []byte("#!/usr/bin/env -S scala\nobject Main {\n  val x = " +
+		strings.Repeat("(", depth) + "1" + strings.Repeat(")", depth) + "\n}\n")
+	lang := detectByShebang(string(src[:bytes.IndexByte(src, '\n')]))
+	if lang == nil || lang.Name != "scala" {
+		t.Fatalf("detectByShebang = %v, want scala", lang)
+	}
+	entries, truncated := computeHighlights(lang, src, tweaks{}, 0)
+	if truncated {
+		t.Fatal("computeHighlights truncated with no budget")
+	}
+	var got []string
+	for _, e := range entries {
+		if e.Name == CaptureStyle("keyword") {
+			got = append(got, string(src[e.Start:e.End]))
+		}
+	}
+	if want := []string{"object", "val"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("scala keywords = %q, want %q", got, want)
+	}
+}
+
+func TestTypeScriptInterface(t *testing.T) {
+	src := []byte("interface Foo { x: number }\n")
+	k, ty := CaptureStyle("keyword"), CaptureStyle("type")
+	want := []string{k + " interface", ty + " Foo", ty + " number"}
+	for _, id := range []string{"typescript", "tsx"} {
+		entries, _ := computeHighlights(langByID(id), src, tweaks{}, 0)
+		var got []string
+		for _, e := range entries {
+			got = append(got, e.Name+" "+string(src[e.Start:e.End]))
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s highlights:\n got %q\nwant %q", id, got, want)
+		}
+	}
+}
+
+// TestJSONCComments checks that jsonc, on the JSON grammar, styles both
+// kinds of comment alongside the JSON around them.
+func TestJSONCComments(t *testing.T) {
+	src := []byte("// settings\n{\"a\": 1, /* two */ \"b\": true}\n")
+	entries, _ := computeHighlights(langByID("jsonc"), src, tweaks{}, 0)
+	var got []string
+	for _, e := range entries {
+		got = append(got, e.Name+" "+string(src[e.Start:e.End]))
+	}
+	c, s, n := CaptureStyle("comment"), CaptureStyle("string"), CaptureStyle("number")
+	want := []string{c + " // settings", s + ` "a"`, n + " 1", c + " /* two */", s + ` "b"`}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("jsonc highlights:\n got %q\nwant %q", got, want)
+	}
+}
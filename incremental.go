@@ -0,0 +1,208 @@
+package treesitter
+
+import (
+	"bytes"
+	"sync"
+	"unicode/utf8"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// bodyEdit is one insertion or deletion from a window's edit log, in rune
+// offsets.
+type bodyEdit struct {
+	insert bool
+	q0, q1 int    // the deleted range, for a deletion
+	text   string // the inserted text, for an insertion
+}
+
+// maxPendingEdits bounds the edits kept between highlights.  Past it the
+// next highlight parses from scratch, which is cheaper than replaying
+// thousands of edits anyway.
+const maxPendingEdits = 1024
+
+// editLog accumulates the edits made to a window between highlights.  The
+// log scanner goroutine adds them and doHighlight takes them.
+type editLog struct {
+	mu       sync.Mutex
+	edits    []bodyEdit
+	overflow bool
+}
+
+// add records e, merging it into the previous edit where typing or
+// repeated deletion continues it, so a burst of keystrokes replays as one
+// edit.
+func (l *editLog) add(e bodyEdit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.overflow {
+		return
+	}
+	if n := len(l.edits); n > 0 {
+		last := &l.edits[n-1]
+		switch {
+		case e.insert && last.insert && e.q0 == last.q0+utf8.RuneCountInString(last.text):
+			last.text += e.text
+			return
+		case !e.insert && !last.insert && e.q1 == last.q0: // backspace
+			last.q0 = e.q0
+			return
+		case !e.insert && !last.insert && e.q0 == last.q0: // forward delete
+			last.q1 += e.q1 - e.q0
+			return
+		}
+	}
+	if len(l.edits) == maxPendingEdits {
+		l.edits, l.overflow = nil, true
+		return
+	}
+	l.edits = append(l.edits, e)
+}
+
+// take returns the edits recorded since the last take and forgets them.
+// ok is false if some were lost to overflow.
+func (l *editLog) take() (edits []bodyEdit, ok bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	edits, ok = l.edits, !l.overflow
+	l.edits, l.overflow = nil, false
+	return edits, ok
+}
+
+// replayEdits applies edits to src, the body tree was parsed from, and
+// edits tree to match, so that it can be passed to a reparse of the
+// result.  It returns the edited body and the edits in bytes, or false if
+// an edit does not fit src, in which case tree is in an unknown state and
+// must not be reused.
+func replayEdits(tree *tree_sitter.Tree, src []byte, edits []bodyEdit) ([]byte, []tree_sitter.InputEdit, bool) {
+	var done []tree_sitter.InputEdit
+	for _, e := range edits {
+		start, ok := runeOffset(src, e.q0)
+		if !ok {
+			return nil, nil, false
+		}
+		startPt := pointAt(src, start)
+		var ie tree_sitter.InputEdit
+		if e.insert {
+			text := []byte(e.text)
+			ie = tree_sitter.InputEdit{
+				StartByte:      uint(start),
+				OldEndByte:     uint(start),
+				NewEndByte:     uint(start + len(text)),
+				StartPosition:  startPt,
+				OldEndPosition: startPt,
+				NewEndPosition: pointAfter(startPt, text),
+			}
+			src = concat(src[:start], text, src[start:])
+		} else {
+			if e.q1 < e.q0 {
+				return nil, nil, false
+			}
+			end, ok := runeOffset(src[start:], e.q1-e.q0)
+			if !ok {
+				return nil, nil, false
+			}
+			end += start
+			ie = tree_sitter.InputEdit{
+				StartByte:      uint(start),
+				OldEndByte:     uint(end),
+				NewEndByte:     uint(start),
+				StartPosition:  startPt,
+				OldEndPosition: pointAfter(startPt, src[start:end]),
+				NewEndPosition: startPt,
+			}
+			src = concat(src[:start], nil, src[end:])
+		}
+		tree.Edit(&ie)
+		done = append(done, ie)
+	}
+	return src, done, true
+}
+
+// maxScopedFraction is the largest part of a body, as a fraction of its
+// length, that restyle redoes in place before it restyles the whole body.
+const maxScopedFraction = 4
+
+// editStyles applies byte edits ies to stylePerByte, the styles of the
+// body before them: deleted bytes' styles go, and inserted bytes start
+// unclaimed.
+func editStyles(stylePerByte []byte, ies []tree_sitter.InputEdit) []byte {
+	for _, ie := range ies {
+		inserted := make([]byte, ie.NewEndByte-ie.StartByte)
+		stylePerByte = concat(stylePerByte[:ie.StartByte], inserted, stylePerByte[ie.OldEndByte:])
+	}
+	return stylePerByte
+}
+
+// dirtyRange returns the bytes of the edited body that ies wrote, as
+// one range [lo, hi), or lo > hi if there were no edits.  A deletion
+// leaves an empty range where it was.
+func dirtyRange(ies []tree_sitter.InputEdit) (lo, hi int) {
+	lo, hi = 1, 0
+	for _, ie := range ies {
+		s, oe, ne := int(ie.StartByte), int(ie.OldEndByte), int(ie.NewEndByte)
+		shift := func(p int) int {
+			switch {
+			case p <= s:
+				return p
+			case p >= oe:
+				return p + ne - oe
+			}
+			return s // inside the deleted text
+		}
+		if lo > hi {
+			lo, hi = s, ne
+			continue
+		}
+		lo, hi = min(shift(lo), s), max(shift(hi), ne)
+	}
+	return lo, hi
+}
+
+// scopeRange widens [lo, hi) to whole top-level nodes of tree: those
+// overlapping or touching it.  Query patterns match within a top-level
+// node, so restyling whole ones catches every capture whose style an edit
+// inside them may have changed, such as an identifier that #match? now
+// rejects.
+func scopeRange(tree *tree_sitter.Tree, lo, hi int) (int, int) {
+	root := tree.RootNode()
+	c := root.FirstChildForByte(uint(max(lo-1, 0)))
+	for ; c != nil && int(c.StartByte()) <= hi; c = c.NextSibling() {
+		lo, hi = min(lo, int(c.StartByte())), max(hi, int(c.EndByte()))
+	}
+	return lo, hi
+}
+
+// concat returns a new slice holding a, b and c.
+func concat(a, b, c []byte) []byte {
+	out := make([]byte, 0, len(a)+len(b)+len(c))
+	return append(append(append(out, a...), b...), c...)
+}
+
+// runeOffset converts rune offset q in src to a byte offset, reporting
+// false if src has fewer than q runes.
+func runeOffset(src []byte, q int) (int, bool) {
+	off := 0
+	for ; q > 0; q-- {
+		if off >= len(src) {
+			return 0, false
+		}
+		_, size := utf8.DecodeRune(src[off:])
+		off += size
+	}
+	return off, true
+}
+
+// pointAt returns the tree-sitter position, row and byte column, of byte
+// offset off in src.
+func pointAt(src []byte, off int) tree_sitter.Point {
+	return pointAfter(tree_sitter.Point{}, src[:off])
+}
+
+// pointAfter returns the position reached by text starting at p.
+func pointAfter(p tree_sitter.Point, text []byte) tree_sitter.Point {
+	if n := bytes.Count(text, []byte("\n")); n > 0 {
+		return tree_sitter.Point{Row: p.Row + uint(n), Column: uint(len(text) - bytes.LastIndexByte(text, '\n') - 1)}
+	}
+	return tree_sitter.Point{Row: p.Row, Column: p.Column + uint(len(text))}
+}
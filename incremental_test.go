@@ -0,0 +1,156 @@
+package treesitter
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestEditLogCoalesce(t *testing.T) {
+	var l editLog
+	for i, c := range "fmt" { // typing
+		l.add(bodyEdit{insert: true, q0: 10 + i, text: string(c)})
+	}
+	l.add(bodyEdit{q0: 12, q1: 13}) // backspace, backspace
+	l.add(bodyEdit{q0: 11, q1: 12})
+	l.add(bodyEdit{q0: 20, q1: 21}) // delete, delete
+	l.add(bodyEdit{q0: 20, q1: 21})
+	want := []bodyEdit{
+		{insert: true, q0: 10, text: "fmt"},
+		{q0: 11, q1: 13},
+		{q0: 20, q1: 22},
+	}
+	if got, ok := l.take(); !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("take = %+v, %v; want %+v, true", got, ok, want)
+	}
+	if got, _ := l.take(); got != nil {
+		t.Errorf("second take = %+v, want nothing", got)
+	}
+
+	for i := 0; i <= maxPendingEdits; i++ {
+		l.add(bodyEdit{insert: true, q0: 2 * i, text: "x"})
+	}
+	if _, ok := l.take(); ok {
+		t.Error("take after overflow reported ok")
+	}
+}
+
+func TestReplayEdits(t *testing.T) {
+	lang := langByID("go")
+	src := []byte("package a\n\n// π is pi.\nvar π = 3\n")
+	tree := parse(lang, src, nil)
+	defer tree.Close()
+
+	edits := []bodyEdit{
+		{q0: 31, q1: 32}, // drop the 3
+		{insert: true, q0: 31, text: "3.14159\n"}, // and write more of it
+		{insert: true, q0: 11, text: "func f() {}\n\n"},
+	}
+	got, ies, ok := replayEdits(tree, src, edits)
+	want := "package a\n\nfunc f() {}\n\n// π is pi.\nvar π = 3.14159\n\n"
+	if !ok || string(got) != want {
+		t.Fatalf("replayEdits = %q, %v; want %q", got, ok, want)
+	}
+	incr := parse(lang, got, tree)
+	defer incr.Close()
+	full := parse(lang, got, nil)
+	defer full.Close()
+	if a, b := incr.RootNode().ToSexp(), full.RootNode().ToSexp(); a != b {
+		t.Errorf("incremental parse differs from full parse:\n%s\n%s", a, b)
+	}
+
+	if len(ies) != len(edits) {
+		t.Errorf("replayEdits returned %d byte edits, want %d", len(ies), len(edits))
+	}
+	if _, _, ok := replayEdits(tree, src, []bodyEdit{{q0: 100, q1: 101}}); ok {
+		t.Error("replayEdits past the end succeeded")
+	}
+}
+
+func TestIncrementalHighlight(t *testing.T) {
+	ctx := context.Background()
+	w := &fakeWin{body: "package a\n\nvar s = 1\n"}
+	sl := &fakeLayer{}
+	s := &session{name: "/src/a.go", lang: langByID("go"), sl: sl, w: w}
+	defer s.dropTree()
+	if err := doHighlight(ctx, s); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		body  string
+		edits []bodyEdit
+	}{
+		{"package a\n\nvar s = \"x\"\n", []bodyEdit{{q0: 19, q1: 20}, {insert: true, q0: 19, text: "\"x\""}}},
+		{"package a\n\n// s.\nvar s = \"x\"\n", []bodyEdit{{insert: true, q0: 11, text: "// s.\n"}}},
+		{"package b\n", []bodyEdit{{insert: true, q0: 0, text: "nonsense"}}}, // does not fit
+	} {
+		for _, e := range tt.edits {
+			s.pending.add(e)
+		}
+		w.body, w.off = tt.body, 0
+		if err := doHighlight(ctx, s); err != nil {
+			t.Fatal(err)
+		}
+		want, _ := computeHighlights(s.lang, []byte(tt.body), tweaks{}, 0)
+		if !reflect.DeepEqual(sl.entries, want) {
+			t.Errorf("body %q: incremental highlight\n%v\nwant\n%v", tt.body, sl.entries, want)
+		}
+		if string(s.treeSrc) != tt.body {
+			t.Errorf("body %q: kept tree is for %q", tt.body, s.treeSrc)
+		}
+	}
+}
+
+func TestScopedRestyle(t *testing.T) {
+	ctx := context.Background()
+	var b strings.Builder
+	b.WriteString("package a\n")
+	for i := 0; i < 12; i++ {
+		fmt.Fprintf(&b, "\n// f%d is a function.\nfunc f%d(x []int) int { return len(x) }\n", i, i)
+	}
+	body := b.String()
+	w := &fakeWin{body: body}
+	sl := &fakeLayer{}
+	s := &session{name: "/src/a.go", lang: langByID("go"), sl: sl, w: w, tweaks: tweaks{todo: [][]byte{[]byte("TODO")}}}
+	defer s.dropTree()
+	if err := doHighlight(ctx, s); err != nil {
+		t.Fatal(err)
+	}
+
+	q := utf8.RuneCountInString(body[:strings.LastIndex(body, "len(")+len("len")])
+	c := utf8.RuneCountInString(body[:strings.Index(body, "is a")])
+	f := utf8.RuneCountInString(body[:strings.LastIndex(body, "func")])
+	for _, step := range []struct {
+		edit bodyEdit
+		text func(string) string
+	}{
+		// len is a builtin; lenx is not.
+		{bodyEdit{insert: true, q0: q, text: "x"}, func(s string) string { return replaceAt(s, q, 0, "x") }},
+		{bodyEdit{q0: q, q1: q + 1}, func(s string) string { return replaceAt(s, q, 1, "") }},
+		// Commenting out a line restyles text that was there before.
+		{bodyEdit{insert: true, q0: f, text: "// "}, func(s string) string { return replaceAt(s, f, 0, "// ") }},
+		{bodyEdit{q0: f, q1: f + 3}, func(s string) string { return replaceAt(s, f, 3, "") }},
+		{bodyEdit{insert: true, q0: c, text: "TODO "}, func(s string) string { return replaceAt(s, c, 0, "TODO ") }},
+	} {
+		body = step.text(body)
+		s.pending.add(step.edit)
+		w.body, w.off = body, 0
+		if err := doHighlight(ctx, s); err != nil {
+			t.Fatal(err)
+		}
+		want, _ := computeHighlights(s.lang, []byte(body), s.tweaks, 0)
+		if !reflect.DeepEqual(sl.entries, want) {
+			t.Errorf("after %+v: scoped restyle\n%v\nwant\n%v", step.edit, sl.entries, want)
+		}
+	}
+}
+
+// replaceAt replaces n runes of s at rune offset q with text.
+func replaceAt(s string, q, n int, text string) string {
+	r := []rune(s)
+	return string(r[:q]) + text + string(r[q+n:])
+}
@@ -0,0 +1,112 @@
+package treesitter
+
+import (
+	"embed"
+	"strings"
+	"time"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// injectionQueries holds the optional per-language injection queries,
+// named queries/injections/<language_id>.scm.  Each match captures the
+// embedded text as @injection.content and the name of its language as
+// @injection.language, or sets the name with (#set! injection.language
+// "name") when the text does not give it.
+//
+//go:embed queries/injections
+var injectionQueries embed.FS
+
+// injectionLanguages maps the language names injection queries capture,
+// such as a fenced code block's info string, to language ids.  Names not
+// listed are left as the outer language styles them.
+var injectionLanguages = map[string]string{
+	"go":         "go",
+	"golang":     "go",
+	"bash":       "bash",
+	"sh":         "bash",
+	"shell":      "bash",
+	"python":     "python",
+	"py":         "python",
+	"javascript": "javascript",
+	"js":         "javascript",
+	"typescript": "typescript",
+	"ts":         "typescript",
+}
+
+// claimInjections restyles the regions of tree, parsed from src with
+// lang's grammar, that lang's injection query hands to another language
+// and that overlap bytes [lo, hi).  Each region is parsed on its own with
+// the injected grammar and its styles replace the outer ones there.  Both
+// are looked up in tw's palette, so inner and outer captures of the same
+// name share a style.
+//
+// Regions lie within top-level nodes, so a range widened by scopeRange
+// holds the whole of each region it overlaps.  If budget is positive it
+// bounds the injections as a whole, as it does the outer query.
+func claimInjections(lang *Language, tree *tree_sitter.Tree, src []byte, tw tweaks, budget time.Duration, stylePerByte []byte, lo, hi int) (truncated bool) {
+	if lang.injections == nil {
+		return false
+	}
+	qc := tree_sitter.NewQueryCursor()
+	defer qc.Close()
+	if lo > 0 || hi < len(src) {
+		qc.SetByteRange(uint(lo), uint(hi))
+	}
+
+	var deadline time.Time
+	if budget > 0 {
+		deadline = time.Now().Add(budget)
+	}
+	captureNames := lang.injections.CaptureNames()
+	matches := qc.Matches(lang.injections, tree.RootNode(), src)
+	for match := matches.Next(); match != nil; match = matches.Next() {
+		var name string
+		for _, p := range lang.injections.PropertySettings(match.PatternIndex) {
+			if p.Key == "injection.language" && p.Value != nil {
+				name = *p.Value
+			}
+		}
+		start, end := -1, -1
+		for _, c := range match.Captures {
+			switch captureNames[c.Index] {
+			case "injection.language":
+				name = c.Node.Utf8Text(src)
+			case "injection.content":
+				start, end = int(c.Node.StartByte()), int(c.Node.EndByte())
+			}
+		}
+		inner := langByID(injectionLanguages[strings.ToLower(name)])
+		if start < 0 || inner == nil || inner.query == nil {
+			continue
+		}
+		var remaining time.Duration
+		if !deadline.IsZero() {
+			if remaining = time.Until(deadline); remaining <= 0 {
+				return true
+			}
+		}
+		if injectRegion(inner, src[start:end], tw, remaining, stylePerByte[start:end]) {
+			return true
+		}
+	}
+	return false
+}
+
+// injectRegion styles region, the text of an injection, as lang and
+// writes its styles to stylePerByte, which has one byte per byte of
+// region.  Offsets in the inner parse start at the region, so writing
+// into the outer slice shifts them into place.
+func injectRegion(lang *Language, region []byte, tw tweaks, budget time.Duration, stylePerByte []byte) (truncated bool) {
+	if len(region) == 0 {
+		return false
+	}
+	tree := parse(lang, region, nil)
+	defer tree.Close()
+	styles, truncated := styleTree(lang.query, tree, region, tw, budget)
+	if !truncated {
+		truncated = claimInjections(lang, tree, region, tw, budget, styles, 0, len(region))
+	}
+	copy(stylePerByte, styles)
+	return truncated
+}
@@ -0,0 +1,86 @@
+package treesitter
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestMarkdownInjection(t *testing.T) {
+	src := []byte("# Notes\n\n```go\nfunc f() {}\n```\n\n```ruby\ndef f\n```\n")
+	entries, truncated := computeHighlights(langByID("markdown"), src, tweaks{}, 0)
+	if truncated {
+		t.Fatal("computeHighlights truncated with no budget")
+	}
+	// styleOf returns the style of the first occurrence of text at or
+	// after from.  src is ASCII, so rune and byte offsets agree.
+	styleOf := func(text string, from int) string {
+		off := from + bytes.Index(src[from:], []byte(text))
+		for _, e := range entries {
+			if e.Start <= off && off < e.End {
+				return e.Name
+			}
+		}
+		return ""
+	}
+	ruby := bytes.Index(src, []byte("```ruby"))
+	for _, c := range []struct {
+		text    string
+		from    int
+		capture string
+	}{
+		{"Notes", 0, "keyword"},
+		{"func", 0, "keyword"},  // injected Go
+		{"f()", 0, "function"},  // injected Go
+		{"def", ruby, "string"}, // ruby is not injected
+	} {
+		if got, want := styleOf(c.text, c.from), CaptureStyle(c.capture); got != want {
+			t.Errorf("style of %q = %q, want %q (%s)", c.text, got, want, c.capture)
+		}
+	}
+}
+
+func TestInjectionLanguages(t *testing.T) {
+	for name, id := range injectionLanguages {
+		if langByID(id) == nil {
+			t.Errorf("injection language %q: %s not registered", name, id)
+		}
+	}
+}
+
+func TestScriptInjection(t *testing.T) {
+	for _, id := range []string{"svelte", "vue"} {
+		src := []byte("<script>\nconst a = 1\n</script>\n<script lang=\"ts\">\nlet b: number = 2\n</script>\n<style>\np { color: red }\n</style>\n")
+		entries, truncated := computeHighlights(langByID(id), src, tweaks{}, 0)
+		if truncated {
+			t.Fatalf("%s: computeHighlights truncated with no budget", id)
+		}
+		styleOf := func(text string) string {
+			off := bytes.Index(src, []byte(text))
+			for _, e := range entries {
+				if e.Start <= off && off < e.End {
+					return e.Name
+				}
+			}
+			return ""
+		}
+		for _, c := range []struct {
+			text    string
+			capture string
+		}{
+			{"script", "keyword"},
+			{"const", "keyword"}, // injected JavaScript
+			{"let", "keyword"},   // injected TypeScript
+			{"number", "type"},   // injected TypeScript
+			{"\"ts\"", "string"}, // the attribute stays markup
+			{"color", ""},        // no CSS grammar
+		} {
+			want := ""
+			if c.capture != "" {
+				want = CaptureStyle(c.capture)
+			}
+			if got := styleOf(c.text); got != want {
+				t.Errorf("%s: style of %q = %q, want %q", id, c.text, got, want)
+			}
+		}
+	}
+}
@@ -2,17 +2,39 @@ package treesitter
 
 import (
 	_ "embed"
+	"errors"
+	"fmt"
+	"io/fs"
 	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 
+	tree_sitter_awk "github.com/Beaglefoot/tree-sitter-awk/bindings/go"
+	tree_sitter_solidity "github.com/JoranHonig/tree-sitter-solidity/bindings/go"
+	tree_sitter_cue "github.com/eonpatapon/tree-sitter-cue/bindings/go"
+	tree_sitter_clojure "github.com/sogaiu/tree-sitter-clojure/bindings/go"
+	tree_sitter_markdown "github.com/tree-sitter-grammars/tree-sitter-markdown/bindings/go"
+	tree_sitter_objc "github.com/tree-sitter-grammars/tree-sitter-objc/bindings/go"
+	tree_sitter_svelte "github.com/tree-sitter-grammars/tree-sitter-svelte/bindings/go"
+	tree_sitter_vim "github.com/tree-sitter-grammars/tree-sitter-vim/bindings/go"
 	tree_sitter "github.com/tree-sitter/go-tree-sitter"
 	tree_sitter_bash "github.com/tree-sitter/tree-sitter-bash/bindings/go"
 	tree_sitter_c "github.com/tree-sitter/tree-sitter-c/bindings/go"
+	tree_sitter_cpp "github.com/tree-sitter/tree-sitter-cpp/bindings/go"
 	tree_sitter_go "github.com/tree-sitter/tree-sitter-go/bindings/go"
+	tree_sitter_html "github.com/tree-sitter/tree-sitter-html/bindings/go"
 	tree_sitter_java "github.com/tree-sitter/tree-sitter-java/bindings/go"
 	tree_sitter_js "github.com/tree-sitter/tree-sitter-javascript/bindings/go"
+	tree_sitter_json "github.com/tree-sitter/tree-sitter-json/bindings/go"
 	tree_sitter_python "github.com/tree-sitter/tree-sitter-python/bindings/go"
+	tree_sitter_racket "github.com/tree-sitter/tree-sitter-racket/bindings/go"
 	tree_sitter_rust "github.com/tree-sitter/tree-sitter-rust/bindings/go"
 	tree_sitter_scala "github.com/tree-sitter/tree-sitter-scala/bindings/go"
+	tree_sitter_scheme "github.com/tree-sitter/tree-sitter-scheme/bindings/go"
+	tree_sitter_typescript "github.com/tree-sitter/tree-sitter-typescript/bindings/go"
+	tree_sitter_verilog "github.com/tree-sitter/tree-sitter-verilog/bindings/go"
 )
 
 //go:embed queries/go.scm
@@ -21,6 +43,9 @@ var goHighlights string
 //go:embed queries/c.scm
 var cHighlights string
 
+//go:embed queries/cpp.scm
+var cppHighlights string
+
 //go:embed queries/python.scm
 var pythonHighlights string
 
@@ -39,12 +64,60 @@ var javaHighlights string
 //go:embed queries/scala.scm
 var scalaHighlights string
 
+//go:embed queries/clojure.scm
+var clojureHighlights string
+
+//go:embed queries/vim.scm
+var vimHighlights string
+
+//go:embed queries/cue.scm
+var cueHighlights string
+
+//go:embed queries/awk.scm
+var awkHighlights string
+
+//go:embed queries/solidity.scm
+var solidityHighlights string
+
+//go:embed queries/jsonc.scm
+var jsoncHighlights string
+
+//go:embed queries/racket.scm
+var racketHighlights string
+
+//go:embed queries/scheme.scm
+var schemeHighlights string
+
+//go:embed queries/objc.scm
+var objcHighlights string
+
+//go:embed queries/svelte.scm
+var svelteHighlights string
+
+//go:embed queries/vue.scm
+var vueHighlights string
+
+//go:embed queries/verilog.scm
+var verilogHighlights string
+
+//go:embed queries/typescript.scm
+var typescriptHighlights string
+
+//go:embed queries/tsx.scm
+var tsxHighlights string
+
+//go:embed queries/markdown.scm
+var markdownHighlights string
+
 // Language bundles a compiled tree-sitter Language pointer and a pre-compiled
 // Query.  Both are safe to share across goroutines (read-only after init).
 type Language struct {
-	Name  string
-	lang  *tree_sitter.Language
-	query *tree_sitter.Query // nil if query compilation failed
+	Name       string
+	lang       *tree_sitter.Language
+	query      *tree_sitter.Query // nil if query compilation failed
+	folds      *tree_sitter.Query // nil if the language has no fold query
+	lite       *tree_sitter.Query // query with only comment and string captures
+	injections *tree_sitter.Query // nil if the language embeds no others
 }
 
 // langByName maps language_id strings → *Language.
@@ -64,13 +137,29 @@ func init() {
 	}{
 		{"go", tree_sitter.NewLanguage(tree_sitter_go.Language()), goHighlights},
 		{"c", tree_sitter.NewLanguage(tree_sitter_c.Language()), cHighlights},
-		{"cpp", tree_sitter.NewLanguage(tree_sitter_c.Language()), cHighlights}, // fallback: use C grammar for C++ until tree-sitter-cpp is added
+		{"cpp", tree_sitter.NewLanguage(tree_sitter_cpp.Language()), cppHighlights},
 		{"python", tree_sitter.NewLanguage(tree_sitter_python.Language()), pythonHighlights},
 		{"rust", tree_sitter.NewLanguage(tree_sitter_rust.Language()), rustHighlights},
 		{"javascript", tree_sitter.NewLanguage(tree_sitter_js.Language()), jsHighlights},
 		{"bash", tree_sitter.NewLanguage(tree_sitter_bash.Language()), bashHighlights},
 		{"java", tree_sitter.NewLanguage(tree_sitter_java.Language()), javaHighlights},
 		{"scala", tree_sitter.NewLanguage(tree_sitter_scala.Language()), scalaHighlights},
+		{"clojure", tree_sitter.NewLanguage(tree_sitter_clojure.Language()), clojureHighlights},
+		{"vim", tree_sitter.NewLanguage(tree_sitter_vim.Language()), vimHighlights},
+		{"cue", tree_sitter.NewLanguage(tree_sitter_cue.Language()), cueHighlights},
+		{"starlark", tree_sitter.NewLanguage(tree_sitter_python.Language()), pythonHighlights}, // not a Starlark grammar: the Python grammar and query under another id
+		{"awk", tree_sitter.NewLanguage(tree_sitter_awk.Language()), awkHighlights},
+		{"solidity", tree_sitter.NewLanguage(tree_sitter_solidity.Language()), solidityHighlights},
+		{"jsonc", tree_sitter.NewLanguage(tree_sitter_json.Language()), jsoncHighlights}, // JSON grammar; it takes comments as extras
+		{"racket", tree_sitter.NewLanguage(tree_sitter_racket.Language()), racketHighlights},
+		{"scheme", tree_sitter.NewLanguage(tree_sitter_scheme.Language()), schemeHighlights},
+		{"objc", tree_sitter.NewLanguage(tree_sitter_objc.Language()), objcHighlights},
+		{"svelte", tree_sitter.NewLanguage(tree_sitter_svelte.Language()), svelteHighlights},
+		{"vue", tree_sitter.NewLanguage(tree_sitter_html.Language()), vueHighlights}, // HTML grammar; {{ }} interpolations are left as text
+		{"verilog", tree_sitter.NewLanguage(tree_sitter_verilog.Language()), verilogHighlights},
+		{"typescript", tree_sitter.NewLanguage(tree_sitter_typescript.LanguageTypescript()), typescriptHighlights},
+		{"tsx", tree_sitter.NewLanguage(tree_sitter_typescript.LanguageTSX()), tsxHighlights},
+		{"markdown", tree_sitter.NewLanguage(tree_sitter_markdown.Language()), markdownHighlights}, // block grammar; inline markup is left unstyled
 	}
 
 	langByName = make(map[string]*Language, len(specs))
@@ -84,11 +173,105 @@ func init() {
 			l.query = q
 			// q is never closed; it lives for the process lifetime and is
 			// shared (read-only) across all goroutines.
+			l.lite = liteQuery(s.lang, s.query)
+		}
+		if src, err := foldQueries.ReadFile("queries/folds/" + s.id + ".scm"); err == nil {
+			q, qerr := tree_sitter.NewQuery(s.lang, string(src))
+			if qerr != nil {
+				log.Printf("lang %s: fold query error at offset %d: %s", s.id, qerr.Offset, qerr.Message)
+			} else {
+				l.folds = q
+			}
+		}
+		if src, err := injectionQueries.ReadFile("queries/injections/" + s.id + ".scm"); err == nil {
+			q, qerr := tree_sitter.NewQuery(s.lang, string(src))
+			if qerr != nil {
+				log.Printf("lang %s: injection query error at offset %d: %s", s.id, qerr.Offset, qerr.Message)
+			} else {
+				l.injections = q
+			}
 		}
 		langByName[s.id] = l
 	}
 }
 
+// LoadQueryDir replaces the highlight query of each language that has a
+// file dir/<language_id>.scm with the query in that file.  A file that
+// cannot be read or does not compile is reported in the error, and its
+// language keeps the query it had.  It must be called before any window
+// is highlighted.
+func LoadQueryDir(dir string) error {
+	ids := make([]string, 0, len(langByName))
+	for id := range langByName {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	var errs []error
+	for _, id := range ids {
+		l := langByName[id]
+		path := filepath.Join(dir, id+".scm")
+		src, err := os.ReadFile(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		} else if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		q, qerr := tree_sitter.NewQuery(l.lang, string(src))
+		if qerr != nil {
+			errs = append(errs, fmt.Errorf("%s: query error at offset %d: %s", path, qerr.Offset, qerr.Message))
+			continue
+		}
+		l.query, l.lite = q, liteQuery(l.lang, string(src))
+	}
+	return errors.Join(errs...)
+}
+
+// liteQuery compiles src a second time with every capture but comments and
+// strings disabled, for the cheap highlight of oversized bodies.
+func liteQuery(lang *tree_sitter.Language, src string) *tree_sitter.Query {
+	q, qerr := tree_sitter.NewQuery(lang, src)
+	if qerr != nil {
+		return nil
+	}
+	for _, name := range q.CaptureNames() {
+		if !isLiteCapture(name) {
+			q.DisableCapture(name)
+		}
+	}
+	return q
+}
+
+// isLiteCapture reports whether capture name is kept by liteQuery.
+func isLiteCapture(name string) bool {
+	for _, keep := range []string{"comment", "string"} {
+		if name == keep || strings.HasPrefix(name, keep+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// QueryCaptures returns the capture names used by language id's highlight
+// query, in query order, and the query's pattern count.  It is for
+// debugging queries; see the -dumpquery flag.
+func QueryCaptures(id string) (names []string, patterns int, err error) {
+	l := langByID(id)
+	if l == nil {
+		return nil, 0, fmt.Errorf("unknown language %q", id)
+	}
+	if l.query == nil {
+		return nil, 0, fmt.Errorf("language %q has no highlight query (it failed to compile)", id)
+	}
+	return l.query.CaptureNames(), int(l.query.PatternCount()), nil
+}
+
+// CaptureStyle returns the palette name a capture is styled with, or ""
+// if captures of that name are not styled.
+func CaptureStyle(capture string) string {
+	return canonicalTable[lookupCaptureIdx(capture)]
+}
+
 // langByID returns the Language for the given language_id, or nil if unknown.
 func langByID(id string) *Language {
 	return langByName[id]
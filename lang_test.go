@@ -1,6 +1,16 @@
 package treesitter
 
-import "testing"
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cptaffe/acme-styles/layer"
+	"github.com/cptaffe/acme-treesitter/config"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
 
 // TestQueryCompilation checks that every registered language has a query that
 // compiled successfully against its grammar.  A failed query is logged (not
@@ -15,5 +25,179 @@ func TestQueryCompilation(t *testing.T) {
 		} else {
 			t.Logf("%s: ok (%d patterns)", name, l.query.PatternCount())
 		}
+		if _, err := foldQueries.ReadFile("queries/folds/" + name + ".scm"); err == nil && l.folds == nil {
+			t.Errorf("%s: fold query failed to compile", name)
+		}
+		if _, err := injectionQueries.ReadFile("queries/injections/" + name + ".scm"); err == nil && l.injections == nil {
+			t.Errorf("%s: injection query failed to compile", name)
+		}
+	}
+}
+
+// TestLanguagesRegistered checks that every grammar the default config and
+// shebang table can name is linked in.
+func TestLanguagesRegistered(t *testing.T) {
+	for _, id := range []string{
+		"go", "c", "cpp", "python", "rust", "javascript", "bash", "java", "scala",
+		"clojure",
+		"vim",
+		"cue", "starlark",
+		"awk",
+		"solidity",
+		"jsonc",
+		"racket",
+		"scheme",
+		"objc",
+		"svelte",
+		"vue",
+		"verilog",
+		"typescript", "tsx",
+		"markdown",
+	} {
+		if langByID(id) == nil {
+			t.Errorf("%s: not registered", id)
+		}
+	}
+}
+
+func TestFirstRegistered(t *testing.T) {
+	if l := firstRegistered([]string{"no-such-lang", "go", "c"}); l == nil || l.Name != "go" {
+		t.Errorf("firstRegistered(no-such-lang, go, c) = %v, want go", l)
+	}
+	if l := firstRegistered([]string{"no-such-lang"}); l != nil {
+		t.Errorf("firstRegistered(no-such-lang) = %s, want nil", l.Name)
+	}
+}
+
+// TestDefaultHandlers checks that the shipped default config tells C++
+// sources apart from C ones, and catches JSON-with-comments and Bazel files
+// by name.
+func TestDefaultHandlers(t *testing.T) {
+	cfg, err := config.Load("config/default.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	handlers, err := CompileHandlers(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]string{
+		"/src/a.c":   "c",
+		"/src/a.h":   "c",
+		"/src/a.cpp": "cpp",
+		"/src/a.cc":  "cpp",
+		"/src/a.c++": "cpp",
+		"/src/a.hpp": "cpp",
+
+		"/src/tsconfig.json":         "jsonc",
+		"/src/tsconfig.base.json":    "jsonc",
+		"/src/.vscode/settings.json": "jsonc",
+		"/src/a.jsonc":               "jsonc",
+
+		"/src/BUILD":            "starlark",
+		"/src/BUILD.bazel":      "starlark",
+		"/src/WORKSPACE":        "starlark",
+		"/src/MODULE.bazel":     "starlark",
+		"/src/defs.bzl":         "starlark",
+		"/src/BUILDING.md":      "markdown",
+		"/src/tools/WORKSPACEx": "",
+
+		"/src/a.rkt":         "racket",
+		"/src/a.ss":          "scheme",
+		"/src/queries/a.scm": "",
+
+		"/src/a.m":  "objc",
+		"/src/a.mm": "objc",
+	} {
+		got := ""
+		if l, _ := detectLanguage(handlers, name); l != nil {
+			got = l.Name
+		}
+		if got != want {
+			t.Errorf("detectLanguage(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestAnchorPatterns(t *testing.T) {
+	cfg := &config.Config{
+		AnchorPatterns: true,
+		FilenameHandlers: []config.FilenameHandler{
+			{Pattern: `go`, LanguageID: config.LanguageIDs{"go"}},
+			{Pattern: `.*/Makefile`, LanguageID: config.LanguageIDs{"bash"}},
+			{Pattern: `\.py$`, LanguageID: config.LanguageIDs{"python"}},
+		},
+	}
+	handlers, err := CompileHandlers(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]string{
+		"/src/mongo.py":   "python", // "go" no longer matches part of the name
+		"go":              "go",
+		"/src/Makefile":   "bash",
+		"/src/Makefile.x": "",
+	} {
+		got := ""
+		if l, _ := detectLanguage(handlers, name); l != nil {
+			got = l.Name
+		}
+		if got != want {
+			t.Errorf("detectLanguage(%q) = %q, want %q", name, got, want)
+		}
+	}
+	for pat, want := range map[string]string{
+		`go`:       `\A(?:go)\z`,
+		`^/etc/`:   `^/etc/`,
+		`[^/]+\.c`: `\A(?:[^/]+\.c)\z`,
+		`a|b$`:     `a|b$`,
+	} {
+		if got := anchorPattern(pat); got != want {
+			t.Errorf("anchorPattern(%q) = %q, want %q", pat, got, want)
+		}
+	}
+}
+
+func TestDefaultConfigLanguages(t *testing.T) {
+	cfg, err := config.Read(strings.NewReader(config.Default), "default.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, fh := range cfg.FilenameHandlers {
+		if firstRegistered(fh.LanguageID) == nil {
+			t.Errorf("default handler %q: no registered language in %v", fh.Pattern, fh.LanguageID)
+		}
+	}
+}
+
+func TestLoadQueryDir(t *testing.T) {
+	goLang, cLang := langByID("go"), langByID("c")
+	defer func(gq, gl, cq, cl *tree_sitter.Query) {
+		goLang.query, goLang.lite, cLang.query, cLang.lite = gq, gl, cq, cl
+	}(goLang.query, goLang.lite, cLang.query, cLang.lite)
+	cQuery := cLang.query
+
+	dir := t.TempDir()
+	for name, query := range map[string]string{
+		"go.scm": "(comment) @string\n",
+		"c.scm":  "(no_such_node) @string\n",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(query), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	err := LoadQueryDir(dir)
+	if err == nil || !strings.Contains(err.Error(), "c.scm") {
+		t.Errorf("LoadQueryDir error = %v, want one naming c.scm", err)
+	}
+	if cLang.query != cQuery {
+		t.Error("c: query replaced by one that does not compile")
+	}
+
+	src := []byte("package a // x\n")
+	entries, _ := computeHighlights(goLang, src, tweaks{}, 0)
+	want := []layer.Entry{{Name: CaptureStyle("string"), Start: 10, End: 14}}
+	if !reflect.DeepEqual(entries, want) {
+		t.Errorf("go with query_dir query: %+v, want %+v", entries, want)
 	}
 }
@@ -0,0 +1,36 @@
+(comment) @comment
+
+(string) @string
+(regex) @string.regexp
+(number) @number
+
+[
+  "BEGIN"
+  "END"
+  "function"
+  "if"
+  "else"
+  "while"
+  "for"
+  "do"
+  "exit"
+  "return"
+  "delete"
+  "getline"
+  "in"
+] @keyword
+
+[
+  (break_statement)
+  (continue_statement)
+  (next_statement)
+  (nextfile_statement)
+] @keyword
+
+(func_def
+  name: (identifier) @function)
+
+(func_call
+  name: (identifier) @function)
+
+(identifier) @variable
@@ -1,6 +1,11 @@
+; Only the literal parts of a double-quoted string are @string, so the
+; commands and expansions inside it keep their own highlighting.
+(string "\"" @string)
+(string_content) @string
+
 [
-  (string)
   (raw_string)
+  (ansi_c_string)
   (heredoc_body)
   (heredoc_start)
 ] @string
@@ -35,11 +40,14 @@
 
 (file_descriptor) @number
 
+(command_substitution ["$(" "`" ")"] @operator)
+(process_substitution ["<(" ">(" ")"] @operator)
+
 [
   (command_substitution)
   (process_substitution)
   (expansion)
-]@embedded
+] @embedded
 
 [
   "$"
@@ -0,0 +1,25 @@
+; Literals
+
+(comment) @comment
+(dis_expr) @comment
+
+(str_lit) @string
+(regex_lit) @string.regexp
+(char_lit) @string
+(num_lit) @number
+(bool_lit) @constant.builtin
+(nil_lit) @constant.builtin
+(kwd_lit) @string.special.symbol
+
+; Special forms and core definition macros
+
+((sym_lit) @keyword
+ (#match? @keyword "^(def|defn|defn-|defmacro|defmulti|defmethod|defprotocol|defrecord|deftype|definterface|defonce|ns|fn|fn\\*|let|letfn|loop|recur|if|if-let|if-not|when|when-let|when-not|cond|condp|case|do|doseq|dotimes|for|quote|var|try|catch|finally|throw|new|set!)$"))
+
+; Function calls: the head of a list form
+
+(list_lit
+  .
+  (sym_lit) @function)
+
+(sym_lit) @variable
@@ -0,0 +1,121 @@
+(identifier) @variable
+
+((identifier) @constant
+ (#match? @constant "^[A-Z][A-Z\\d_]*$"))
+
+"break" @keyword
+"case" @keyword
+"const" @keyword
+"continue" @keyword
+"default" @keyword
+"do" @keyword
+"else" @keyword
+"enum" @keyword
+"extern" @keyword
+"for" @keyword
+"if" @keyword
+"inline" @keyword
+"return" @keyword
+"sizeof" @keyword
+"static" @keyword
+"struct" @keyword
+"switch" @keyword
+"typedef" @keyword
+"union" @keyword
+"volatile" @keyword
+"while" @keyword
+
+"catch" @keyword
+"class" @keyword
+"constexpr" @keyword
+"delete" @keyword
+"explicit" @keyword
+"final" @keyword
+"friend" @keyword
+"namespace" @keyword
+"new" @keyword
+"noexcept" @keyword
+"operator" @keyword
+"override" @keyword
+"private" @keyword
+"protected" @keyword
+"public" @keyword
+"template" @keyword
+"throw" @keyword
+"try" @keyword
+"typename" @keyword
+"using" @keyword
+(this) @keyword
+
+"#define" @keyword
+"#elif" @keyword
+"#else" @keyword
+"#endif" @keyword
+"#if" @keyword
+"#ifdef" @keyword
+"#ifndef" @keyword
+"#include" @keyword
+(preproc_directive) @keyword
+
+"--" @operator
+"-" @operator
+"-=" @operator
+"->" @operator
+"::" @operator
+"=" @operator
+"!=" @operator
+"*" @operator
+"&" @operator
+"&&" @operator
+"+" @operator
+"++" @operator
+"+=" @operator
+"<" @operator
+"==" @operator
+">" @operator
+"||" @operator
+
+"." @delimiter
+";" @delimiter
+
+(string_literal) @string
+(raw_string_literal) @string
+(system_lib_string) @string
+
+(null) @constant
+(number_literal) @number
+(char_literal) @number
+
+(field_identifier) @property
+(statement_identifier) @label
+(namespace_identifier) @type
+(type_identifier) @type
+(primitive_type) @type
+(sized_type_specifier) @type
+(auto) @type
+
+(call_expression
+  function: (identifier) @function)
+(call_expression
+  function: (qualified_identifier
+    name: (identifier) @function))
+(call_expression
+  function: (field_expression
+    field: (field_identifier) @function))
+(template_function
+  name: (identifier) @function)
+(template_method
+  name: (field_identifier) @function)
+(function_declarator
+  declarator: (identifier) @function)
+(function_declarator
+  declarator: (qualified_identifier
+    name: (identifier) @function))
+(function_declarator
+  declarator: (field_identifier) @function)
+(destructor_name) @function
+(operator_name) @function
+(preproc_function_def
+  name: (identifier) @function.special)
+
+(comment) @comment
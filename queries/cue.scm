@@ -0,0 +1,28 @@
+(comment) @comment
+
+(string) @string
+
+[
+  (number)
+  (float)
+] @number
+
+[
+  (boolean)
+  (null)
+] @constant.builtin
+
+[
+  "package"
+  "import"
+  "for"
+  "in"
+  "if"
+  "let"
+] @keyword
+
+(field
+  (label
+    (identifier) @property))
+
+(identifier) @variable
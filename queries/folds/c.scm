@@ -0,0 +1,12 @@
+[
+  (function_definition)
+  (struct_specifier)
+  (union_specifier)
+  (enum_specifier)
+  (if_statement)
+  (for_statement)
+  (while_statement)
+  (switch_statement)
+  (preproc_if)
+  (preproc_ifdef)
+] @fold
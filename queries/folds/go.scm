@@ -0,0 +1,15 @@
+[
+  (function_declaration)
+  (method_declaration)
+  (func_literal)
+  (import_declaration)
+  (const_declaration)
+  (var_declaration)
+  (type_declaration)
+  (composite_literal)
+  (if_statement)
+  (for_statement)
+  (expression_switch_statement)
+  (type_switch_statement)
+  (select_statement)
+] @fold
@@ -0,0 +1,11 @@
+[
+  (class_declaration)
+  (interface_declaration)
+  (enum_declaration)
+  (method_declaration)
+  (constructor_declaration)
+  (if_statement)
+  (for_statement)
+  (while_statement)
+  (switch_expression)
+] @fold
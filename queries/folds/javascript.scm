@@ -0,0 +1,12 @@
+[
+  (function_declaration)
+  (function_expression)
+  (arrow_function)
+  (class_declaration)
+  (method_definition)
+  (object)
+  (array)
+  (if_statement)
+  (for_statement)
+  (switch_statement)
+] @fold
@@ -0,0 +1,11 @@
+[
+  (function_definition)
+  (class_definition)
+  (if_statement)
+  (for_statement)
+  (while_statement)
+  (with_statement)
+  (try_statement)
+  (dictionary)
+  (list)
+] @fold
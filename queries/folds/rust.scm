@@ -0,0 +1,14 @@
+[
+  (function_item)
+  (impl_item)
+  (trait_item)
+  (struct_item)
+  (enum_item)
+  (mod_item)
+  (macro_definition)
+  (match_expression)
+  (if_expression)
+  (for_expression)
+  (while_expression)
+  (loop_expression)
+] @fold
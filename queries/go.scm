@@ -120,4 +120,11 @@
   (iota)
 ] @constant.builtin
 
+; Build constraints and compiler directives, which must come before the
+; plain comment pattern to win.  They are styled d, which the acme-styles
+; palette must define (see token_names.txt).
+
+((comment) @comment.directive
+  (#match? @comment.directive "^//( \\+build |go:[a-z]|line |export |extern )"))
+
 (comment) @comment
@@ -0,0 +1,7 @@
+; Fenced code blocks are highlighted as the language their info string
+; names, when it is one of injectionLanguages.
+
+(fenced_code_block
+  (info_string
+    (language) @injection.language)
+  (code_fence_content) @injection.content)
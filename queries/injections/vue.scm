@@ -0,0 +1,21 @@
+; <script> bodies are highlighted as JavaScript, or as the language a
+; lang attribute names, such as lang="ts".  <style> bodies are left as
+; the markup query styles them: no CSS grammar is registered.
+
+((script_element
+  (start_tag) @_tag
+  (raw_text) @injection.content)
+  (#not-match? @_tag "\\slang\\s*=")
+  (#set! injection.language "javascript"))
+
+((script_element
+  (start_tag
+    (attribute
+      (attribute_name) @_attr
+      [
+        (attribute_value) @injection.language
+        (quoted_attribute_value
+          (attribute_value) @injection.language)
+      ]))
+  (raw_text) @injection.content)
+  (#eq? @_attr "lang"))
@@ -0,0 +1,14 @@
+(comment) @comment
+
+(string) @string
+
+(number) @number
+
+[
+  (true)
+  (false)
+  (null)
+] @constant.builtin
+
+(pair
+  key: (_) @property)
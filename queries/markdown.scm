@@ -0,0 +1,26 @@
+; Block structure only: emphasis, code spans and links within paragraphs
+; belong to the separate inline grammar, which is not registered.  Fenced
+; code blocks in a known language are restyled by the injection query.
+
+[
+  (atx_heading)
+  (setext_heading)
+] @keyword
+
+[
+  (list_marker_plus)
+  (list_marker_minus)
+  (list_marker_star)
+  (list_marker_dot)
+  (list_marker_parenthesis)
+  (block_quote_marker)
+  (thematic_break)
+] @operator
+
+[
+  (indented_code_block)
+  (fenced_code_block)
+] @string
+
+(link_reference_definition
+  (link_destination) @string)
@@ -0,0 +1,51 @@
+(comment) @comment
+
+[
+  (string_literal)
+  (system_lib_string)
+  (char_literal)
+] @string
+
+(number_literal) @number
+
+[
+  "@interface"
+  "@implementation"
+  "@protocol"
+  "@end"
+  "@property"
+  "@synthesize"
+  "@selector"
+  "@autoreleasepool"
+  "@try"
+  "@catch"
+  "@finally"
+  "break"
+  "case"
+  "const"
+  "continue"
+  "default"
+  "do"
+  "else"
+  "enum"
+  "for"
+  "if"
+  "return"
+  "static"
+  "struct"
+  "switch"
+  "typedef"
+  "while"
+  "#define"
+  "#if"
+  "#ifdef"
+  "#ifndef"
+  "#endif"
+  "#include"
+  "#import"
+] @keyword
+
+[
+  (primitive_type)
+  (type_identifier)
+] @type
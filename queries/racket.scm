@@ -0,0 +1,25 @@
+[
+  (comment)
+  (block_comment)
+] @comment
+
+[
+  (string)
+  (character)
+] @string
+
+[
+  (number)
+  (boolean)
+] @number
+
+(keyword) @constant
+
+(list
+  .
+  (symbol) @keyword
+  (#match? @keyword "^(define|define-syntax|define-values|define-record-type|lambda|λ|let|let\\*|letrec|letrec\\*|let-values|if|cond|case|when|unless|else|and|or|not|begin|do|set!|quote|quasiquote|unquote|syntax-rules|syntax-case|import|export|library|module|require|provide|struct|match|parameterize|delay|guard)$"))
+
+(list
+  .
+  (symbol) @function)
@@ -0,0 +1,38 @@
+(comment) @comment
+
+[
+  (string)
+  (hex_string_literal)
+  (unicode_string_literal)
+] @string
+
+(number_literal) @number
+
+[
+  "contract"
+  "interface"
+  "library"
+  "function"
+  "modifier"
+  "event"
+  "struct"
+  "enum"
+  "mapping"
+  "return"
+  "returns"
+  "if"
+  "else"
+  "for"
+  "while"
+  "emit"
+  "import"
+  "pragma"
+] @keyword
+
+(type_name) @type
+
+(function_definition
+  name: (identifier) @function)
+
+(contract_declaration
+  name: (identifier) @type)
@@ -0,0 +1,8 @@
+(comment) @comment
+
+(tag_name) @keyword
+
+[
+  (attribute_value)
+  (quoted_attribute_value)
+] @string
@@ -0,0 +1,44 @@
+(comment) @comment
+
+(double_quoted_string) @string
+
+[
+  (integral_number)
+  (unsigned_number)
+  (real_number)
+] @number
+
+[
+  "module"
+  "endmodule"
+  "input"
+  "output"
+  "inout"
+  "wire"
+  "reg"
+  "logic"
+  "parameter"
+  "localparam"
+  "assign"
+  "always"
+  "always_ff"
+  "always_comb"
+  "initial"
+  "begin"
+  "end"
+  "if"
+  "else"
+  "case"
+  "endcase"
+  "for"
+  "function"
+  "endfunction"
+  "task"
+  "endtask"
+  "generate"
+  "endgenerate"
+  "posedge"
+  "negedge"
+] @keyword
+
+(system_tf_identifier) @function.builtin
@@ -0,0 +1,36 @@
+(comment) @comment
+
+(string_literal) @string
+
+[
+  (integer_literal)
+  (float_literal)
+] @number
+
+[
+  "if"
+  "else"
+  "elseif"
+  "endif"
+  "for"
+  "endfor"
+  "while"
+  "endwhile"
+  "function"
+  "endfunction"
+  "return"
+  "let"
+  "call"
+  "try"
+  "catch"
+  "finally"
+  "endtry"
+] @keyword
+
+(function_declaration
+  name: (_) @function)
+
+(call_expression
+  function: (identifier) @function)
+
+(identifier) @variable
@@ -0,0 +1,12 @@
+; Vue single-file components are parsed with the HTML grammar, so {{ }}
+; interpolations are plain text.  <script> bodies are injected; see
+; queries/injections/vue.scm.
+
+(comment) @comment
+
+(tag_name) @keyword
+
+[
+  (attribute_value)
+  (quoted_attribute_value)
+] @string
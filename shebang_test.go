@@ -16,6 +16,16 @@ func TestShebanInterpreter(t *testing.T) {
 		{"#!/usr/bin/env -vS node", "node"},
 		{"#!/usr/bin/env jbang", "jbang"},
 		{"#!/usr/bin/env deno", "deno"},
+		{"#!/usr/bin/awk -f", "awk"},
+		{"#!/usr/bin/env gawk -f", "gawk"},
+		// Interpreters invoked directly with their own flags.
+		{"#!/bin/sh -e", "sh"},
+		{"#!/bin/bash -eu -o pipefail", "bash"},
+		{"#!/usr/bin/python3 -u", "python3"},
+		{"#! /bin/sh -x", "sh"},
+		{"#!/bin/sh	-e", "sh"},
+		// Like the kernel, split the interpreter path at whitespace.
+		{"#!/opt/my tools/bin/python3", "my"},
 		{"# not a shebang", ""},
 		{"", ""},
 		{"#!/usr/bin/env -S", ""}, // env -S with nothing after
@@ -45,15 +55,21 @@ func TestLangIDForInterpreter(t *testing.T) {
 		{"nodejs", "javascript"},
 		{"deno", "javascript"},
 		{"bun", "javascript"},
-		{"ts-node", "javascript"},
+		{"ts-node", "typescript"},
 		{"java", "java"},
 		{"jbang", "java"},
 		{"scala", "scala"},
 		{"scala3", "scala"},
 		{"amm", "scala"},
 		{"rust-script", "rust"},
-		{"ruby", ""},   // not registered
-		{"perl", ""},   // not registered
+		{"awk", "awk"},
+		{"gawk", "awk"},
+		{"mawk", "awk"},
+		{"racket", "racket"},
+		{"guile3.0", "scheme"},
+		{"chez", "scheme"},
+		{"ruby", ""}, // not registered
+		{"perl", ""}, // not registered
 		{"", ""},
 	}
 	for _, c := range cases {
@@ -79,9 +95,11 @@ func TestDetectByShebang(t *testing.T) {
 		{"#!/usr/bin/env jbang", "java"},
 		{"#!/usr/bin/env node", "javascript"},
 		{"#!/usr/bin/env deno", "javascript"},
+		{"#!/usr/bin/env ts-node", "typescript"},
 		{"#!/usr/bin/env rust-script", "rust"},
-		{"package main", ""},          // not a shebang
-		{"#!/usr/bin/env ruby", ""},   // grammar not registered
+		{"#!/usr/bin/awk -f", "awk"},
+		{"package main", ""},        // not a shebang
+		{"#!/usr/bin/env ruby", ""}, // grammar not registered
 	}
 	for _, c := range cases {
 		lang := detectByShebang(c.line)
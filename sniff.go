@@ -0,0 +1,83 @@
+package treesitter
+
+import (
+	"bytes"
+	"path/filepath"
+	"regexp"
+)
+
+// sniffLines is how many non-comment lines looksLikeShell examines.
+const sniffLines = 20
+
+// shellMarkers match lines that are hard to mistake for anything but
+// shell.  Plain NAME=value assignments are left out on purpose: make,
+// ini and env files are full of them.
+var shellMarkers = []*regexp.Regexp{
+	regexp.MustCompile(`^(export|unset|readonly|local|declare)\s+[A-Za-z_]\w*(=|\s|$)`),
+	regexp.MustCompile(`^[A-Za-z_]\w*=("|'|\$\(|\$\{|` + "`" + `)`),
+	regexp.MustCompile(`;\s*(then|do)$`),
+	regexp.MustCompile(`^(fi|esac|done)\b`),
+	regexp.MustCompile(`\$\{[A-Za-z_]\w*(:[-=?+]|#|%|})`),
+	regexp.MustCompile(`^(\.|source)\s+\S+$`),
+	regexp.MustCompile(`^set\s+[-+][euxo]`),
+	regexp.MustCompile(`^[A-Za-z_][\w-]*\s*\(\)\s*\{?$`),
+}
+
+// execLines is how many lines after the shebang execInterpreter examines.
+const execLines = 5
+
+// execLine matches the exec of a polyglot header, with or without the
+// quoting that hides it from the other language:
+//
+//	exec python3 "$0" "$@"
+//	''''exec python3 -u -- "$0" "$@" # '''
+//	"exec" "/usr/bin/env" "python3" "$0" "$@"
+var execLine = regexp.MustCompile(`^['"]*exec['"]?\s+(?:['"]?(?:/usr)?/bin/env['"]?\s+)?['"]?([^\s'"]+)`)
+
+// execInterpreter returns the base name of the interpreter a shell script
+// re-executes itself with in the first few lines after its shebang, or ""
+// if it does not.  Only an exec of "$0" counts: a script that execs some
+// other program is still a shell script.
+func execInterpreter(head []byte) string {
+	lines := bytes.SplitN(head, []byte("\n"), execLines+2)
+	if len(lines) > execLines+1 {
+		lines = lines[:execLines+1]
+	}
+	for _, line := range lines[1:] {
+		line = bytes.TrimSpace(line)
+		m := execLine.FindSubmatch(line)
+		if m == nil || !bytes.Contains(line, []byte("$0")) {
+			continue
+		}
+		return filepath.Base(string(m[1]))
+	}
+	return ""
+}
+
+// looksLikeShell reports whether head, the start of a body with no
+// shebang, reads as a shell script.  It is deliberately conservative:
+// at least two of the first few non-comment lines must carry a
+// shell-only construct, since mis-highlighting a file is worse than not
+// highlighting it.
+func looksLikeShell(head []byte) bool {
+	hits, seen := 0, 0
+	for _, line := range bytes.Split(head, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		if seen++; seen > sniffLines {
+			break
+		}
+		for _, re := range shellMarkers {
+			if re.Match(line) {
+				hits++
+				break
+			}
+		}
+		if hits >= 2 {
+			return true
+		}
+	}
+	return false
+}
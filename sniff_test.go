@@ -0,0 +1,45 @@
+package treesitter
+
+import "testing"
+
+func TestLooksLikeShell(t *testing.T) {
+	cases := []struct {
+		name string
+		head string
+		want bool
+	}{
+		{"sourced env", "# Sourced by the build.\nexport GOPATH=\"$HOME/go\"\nexport PATH=\"${PATH}:${GOPATH}/bin\"\n", true},
+		{"configure", "# Generated by hand.\nset -e\nprefix=${PREFIX:-/usr/local}\nif [ -z \"$CC\" ]; then\n\tCC=cc\nfi\n", true},
+		{"functions", "die() {\n\techo \"$@\" >&2\n\texit 1\n}\n. ./lib/common.sh\n", true},
+		{"makefile", "PREFIX = /usr/local\nCC = cc\n\nall:\n\t$(CC) -o x x.c\n", false},
+		{"ini", "[core]\nname=value\nother=1\n", false},
+		{"prose", "Install with make install.\nThen run it.\n", false},
+		{"one marker", "export FOO=1\nplain text follows\n", false},
+		{"comments only", "# export A=1\n# fi\n", false},
+	}
+	for _, c := range cases {
+		if got := looksLikeShell([]byte(c.head)); got != c.want {
+			t.Errorf("%s: looksLikeShell = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestExecInterpreter(t *testing.T) {
+	cases := []struct {
+		name string
+		head string
+		want string
+	}{
+		{"python polyglot", "#!/bin/sh\n''''exec python3 -u -- \"$0\" ${1+\"$@\"} # '''\nimport sys\n", "python3"},
+		{"quoted env", "#!/bin/sh\n\"exec\" \"/usr/bin/env\" \"python3\" \"$0\" \"$@\"\n", "python3"},
+		{"after comment", "#!/bin/sh\n# Run with whatever node is on $PATH.\nexec node \"$0\" \"$@\"\n", "node"},
+		{"other program", "#!/bin/sh\nexec make -C build \"$@\"\n", ""},
+		{"too late", "#!/bin/sh\n\n\n\n\n\n\nexec python3 \"$0\"\n", ""},
+		{"shebang only", "#!/bin/sh\n", ""},
+	}
+	for _, c := range cases {
+		if got := execInterpreter([]byte(c.head)); got != c.want {
+			t.Errorf("%s: execInterpreter = %q, want %q", c.name, got, c.want)
+		}
+	}
+}
@@ -2,6 +2,8 @@ package treesitter
 
 import (
 	_ "embed"
+	"fmt"
+	"sort"
 	"strings"
 	"unicode/utf8"
 
@@ -13,16 +15,26 @@ var tokenNamesData string
 
 // canonicalTable is the ordered list of short palette names derived from
 // token_names.txt.  Index 0 is the "no style" sentinel.
+//
+// The indices are private to this process: layer entries carry the palette
+// name, and acme-styles resolves names against its own palette, so nothing
+// here has to agree with the compositor's style order.  A name missing from
+// the palette is acme-styles' concern, not an index mismatch.
 var canonicalTable []string
 
 // canonicalIndex maps capture name stems and palette names to indices in
 // canonicalTable.  Populated by init() from token_names.txt.
 var canonicalIndex map[string]int
 
+// paletteStem maps each palette name to the first capture stem listed for
+// it, for messages.  Populated by init() from token_names.txt.
+var paletteStem map[string]string
+
 func init() {
 	table := []string{""} // index 0 = unstyled
 	paletteIdx := make(map[string]int) // palette name → index (dedup)
 	index := make(map[string]int)
+	stems := make(map[string]string)
 
 	for _, line := range strings.Split(tokenNamesData, "\n") {
 		line = strings.TrimSpace(line)
@@ -41,12 +53,53 @@ func init() {
 			table = append(table, palette)
 			paletteIdx[palette] = idx
 			index[palette] = idx // palette name maps to itself
+			stems[palette] = source
 		}
 		index[source] = idx
 	}
 
 	canonicalTable = table
 	canonicalIndex = index
+	paletteStem = stems
+}
+
+// CaptureClasses returns a StyleMap that names each styled capture by its
+// stem in token_names.txt ("comment", "comment.todo", …) rather than
+// its palette name, for output such as HTML.
+func CaptureClasses() StyleMap {
+	m := make(StyleMap, len(canonicalIndex))
+	for name, idx := range canonicalIndex {
+		if name != canonicalTable[idx] { // skip the palette names
+			m[name] = name
+		}
+	}
+	return m
+}
+
+// styleSummary counts entries per style, named by capture stem, for debug
+// logs: "comment=12 function=20 string=8".
+func styleSummary(entries []layer.Entry) string {
+	counts := make(map[string]int)
+	for _, e := range entries {
+		name := paletteStem[e.Name]
+		if name == "" {
+			name = e.Name
+		}
+		counts[name]++
+	}
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var b strings.Builder
+	for i, name := range names {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprintf(&b, "%s=%d", name, counts[name])
+	}
+	return b.String()
 }
 
 // lookupCaptureIdx converts a tree-sitter capture name (e.g. "@function.method")
@@ -57,11 +110,27 @@ func init() {
 // Index 0 means "skip this capture".  Callers retrieve the name via
 // canonicalTable[idx].
 func lookupCaptureIdx(captureName string) int {
+	return lookupCaptureIdxFallback(captureName, 0)
+}
+
+// lookupCaptureIdxFallback is lookupCaptureIdx with the fallback limited:
+// at most fallback trailing components are dropped, none if fallback is
+// negative, and any number if it is 0.
+func lookupCaptureIdxFallback(captureName string, fallback int) int {
+	return lookupIdx(canonicalIndex, captureName, fallback)
+}
+
+// lookupIdx is lookupCaptureIdxFallback against index, which maps capture
+// stems to palette indices as canonicalIndex does.
+func lookupIdx(index map[string]int, captureName string, fallback int) int {
 	name := strings.TrimPrefix(captureName, "@")
-	for {
-		if idx, ok := canonicalIndex[name]; ok {
+	for dropped := 0; ; dropped++ {
+		if idx, ok := index[name]; ok {
 			return idx
 		}
+		if fallback < 0 || fallback > 0 && dropped == fallback {
+			return 0
+		}
 		dot := strings.LastIndex(name, ".")
 		if dot < 0 {
 			return 0
@@ -83,16 +152,74 @@ func applyCapture(stylePerByte []byte, start, end, idx int) {
 	}
 }
 
+// applyCapturePriority is applyCapture with a priority table: bytes
+// [start, end) go to idx where they are unclaimed or were claimed by a
+// capture of lower priority prio.  Equal priorities keep the first claim,
+// so ties fall back to query order.
+func applyCapturePriority(stylePerByte []byte, prioPerByte []int, start, end, idx, prio int) {
+	if idx == 0 {
+		return
+	}
+	for i := start; i < end && i < len(stylePerByte); i++ {
+		if stylePerByte[i] == 0 || prio > prioPerByte[i] {
+			stylePerByte[i] = byte(idx)
+			prioPerByte[i] = prio
+		}
+	}
+}
+
+// capturePriority returns the priority of a capture, looked up with the
+// same hierarchical fallback as styles: "function.method" uses the entry
+// for "function" if it has none of its own.  Unlisted captures have 0.
+func capturePriority(table map[string]int, captureName string) int {
+	name := strings.TrimPrefix(captureName, "@")
+	for {
+		if p, ok := table[name]; ok {
+			return p
+		}
+		dot := strings.LastIndex(name, ".")
+		if dot < 0 {
+			return 0
+		}
+		name = name[:dot]
+	}
+}
+
+// coordMode selects the unit of the Start/End offsets compressToEntries
+// emits.
+type coordMode int
+
+const (
+	// runeCoords counts runes, matching acme's addressing and acme-styles.
+	runeCoords coordMode = iota
+	// byteCoords counts bytes, for consumers that address src directly.
+	byteCoords
+)
+
 // compressToEntries converts a per-byte style-index array (stylePerByte[i] is
 // an index into canonicalTable; 0 = unstyled) into a slice of layer.Entry
-// values using rune offsets (Start inclusive, End exclusive).
-func compressToEntries(stylePerByte []byte, src []byte) []layer.Entry {
+// values using offsets in the given mode (Start inclusive, End exclusive).
+func compressToEntries(stylePerByte []byte, src []byte, mode coordMode) []layer.Entry {
+	return compressToEntriesNamed(stylePerByte, src, mode, canonicalTable)
+}
+
+// compressToEntriesNamed is compressToEntries for a palette other than
+// canonicalTable: stylePerByte holds indices into names.
+func compressToEntriesNamed(stylePerByte []byte, src []byte, mode coordMode, names []string) []layer.Entry {
 	var entries []layer.Entry
 	byteOff := 0
 	runeOff := 0
 	curIdx := 0
 	spanStart := 0
 
+	// off is the current position in the requested coordinate space.
+	off := func() int {
+		if mode == byteCoords {
+			return byteOff
+		}
+		return runeOff
+	}
+
 	for byteOff < len(src) {
 		_, size := utf8.DecodeRune(src[byteOff:])
 
@@ -100,13 +227,13 @@ func compressToEntries(stylePerByte []byte, src []byte) []layer.Entry {
 		if idx != curIdx {
 			if curIdx != 0 {
 				entries = append(entries, layer.Entry{
-					Name:  canonicalTable[curIdx],
+					Name:  names[curIdx],
 					Start: spanStart,
-					End:   runeOff,
+					End:   off(),
 				})
 			}
 			curIdx = idx
-			spanStart = runeOff
+			spanStart = off()
 		}
 
 		byteOff += size
@@ -114,9 +241,9 @@ func compressToEntries(stylePerByte []byte, src []byte) []layer.Entry {
 	}
 	if curIdx != 0 {
 		entries = append(entries, layer.Entry{
-			Name:  canonicalTable[curIdx],
+			Name:  names[curIdx],
 			Start: spanStart,
-			End:   runeOff,
+			End:   off(),
 		})
 	}
 	return entries
@@ -0,0 +1,48 @@
+package treesitter
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+)
+
+// tagNoteRE matches an annotation previously written by setTagNote,
+// e.g. " [ts:go 4ms]".
+var tagNoteRE = regexp.MustCompile(`\s*\[ts:[^\]]*\]`)
+
+// setTagNote replaces any acme-treesitter annotation in the user part of
+// w's tag (the text after the first '|') with note.  An empty note just
+// removes the annotation.
+//
+// The tag file only supports appending, so the user part is cleared and
+// rewritten; text typed into the tag in the meantime may be lost.
+func setTagNote(w acmeWin, note string) error {
+	tag, err := w.ReadAll("tag")
+	if err != nil {
+		return err
+	}
+	i := bytes.IndexByte(tag, '|')
+	if i < 0 {
+		return nil
+	}
+	user := string(tag[i+1:])
+	next := withTagNote(user, note)
+	if next == user {
+		return nil
+	}
+	if err := w.Ctl("cleartag"); err != nil {
+		return err
+	}
+	_, err = w.Write("tag", []byte(next))
+	return err
+}
+
+// withTagNote returns the tag text user with any previous annotation
+// removed and note, if non-empty, appended.
+func withTagNote(user, note string) string {
+	user = tagNoteRE.ReplaceAllString(user, "")
+	if note == "" {
+		return user
+	}
+	return strings.TrimRight(user, " ") + " " + note
+}
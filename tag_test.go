@@ -0,0 +1,23 @@
+package treesitter
+
+import "testing"
+
+func TestWithTagNote(t *testing.T) {
+	cases := []struct {
+		user, note string
+		want       string
+	}{
+		{" Put Look ", "[ts:go 4ms]", " Put Look [ts:go 4ms]"},
+		{" Put Look [ts:go 4ms]", "[ts:go 12ms]", " Put Look [ts:go 12ms]"},
+		{" Put Look [ts:go 4ms]", "", " Put Look"},
+		{" Put [ts:go 4ms] Look", "", " Put Look"},
+		{"", "[ts:c 1ms]", " [ts:c 1ms]"},
+		{" Put Look", "", " Put Look"},
+	}
+	for _, c := range cases {
+		got := withTagNote(c.user, c.note)
+		if got != c.want {
+			t.Errorf("withTagNote(%q, %q) = %q, want %q", c.user, c.note, got, c.want)
+		}
+	}
+}
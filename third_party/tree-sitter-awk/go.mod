@@ -0,0 +1,3 @@
+module github.com/Beaglefoot/tree-sitter-awk
+
+go 1.22
@@ -0,0 +1,3 @@
+module github.com/sogaiu/tree-sitter-clojure
+
+go 1.22
@@ -0,0 +1,3 @@
+module github.com/eonpatapon/tree-sitter-cue
+
+go 1.22
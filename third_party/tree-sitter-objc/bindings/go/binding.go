@@ -0,0 +1,12 @@
+package tree_sitter_objc
+
+// #cgo CFLAGS: -std=c11 -fPIC -I${SRCDIR}/../../src
+// #include "../../src/parser.c"
+import "C"
+
+import "unsafe"
+
+// Get the tree-sitter Language for this grammar.
+func Language() unsafe.Pointer {
+	return unsafe.Pointer(C.tree_sitter_objc())
+}
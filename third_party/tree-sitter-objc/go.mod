@@ -0,0 +1,3 @@
+module github.com/tree-sitter-grammars/tree-sitter-objc
+
+go 1.22
@@ -0,0 +1,13 @@
+package tree_sitter_racket
+
+// #cgo CFLAGS: -std=c11 -fPIC -I${SRCDIR}/../../src
+// #include "../../src/parser.c"
+// #include "../../src/scanner.c"
+import "C"
+
+import "unsafe"
+
+// Get the tree-sitter Language for this grammar.
+func Language() unsafe.Pointer {
+	return unsafe.Pointer(C.tree_sitter_racket())
+}
@@ -0,0 +1,3 @@
+module github.com/tree-sitter/tree-sitter-racket
+
+go 1.22
@@ -0,0 +1,3 @@
+module github.com/JoranHonig/tree-sitter-solidity
+
+go 1.22
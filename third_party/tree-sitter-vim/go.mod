@@ -0,0 +1,3 @@
+module github.com/tree-sitter-grammars/tree-sitter-vim
+
+go 1.22
@@ -0,0 +1,50 @@
+package treesitter
+
+import "bytes"
+
+// markTodos restyles each whole-word occurrence of a marker inside a
+// comment (TODO, FIXME and the like) as @comment.todo.  It runs after the
+// capture pass, on bytes already claimed as comment.
+func markTodos(stylePerByte, src []byte, markers [][]byte) {
+	comment := byte(lookupCaptureIdx("comment"))
+	todo := byte(lookupCaptureIdx("comment.todo"))
+	for _, m := range markers {
+		for off := 0; ; {
+			i := bytes.Index(src[off:], m)
+			if i < 0 {
+				break
+			}
+			start, end := off+i, off+i+len(m)
+			off = end
+			if !isWordBoundary(src, start-1) || !isWordBoundary(src, end) {
+				continue
+			}
+			if !allStyled(stylePerByte[start:end], comment) {
+				continue
+			}
+			for j := start; j < end; j++ {
+				stylePerByte[j] = todo
+			}
+		}
+	}
+}
+
+// isWordBoundary reports whether src[i] does not continue a word; offsets
+// outside src count as boundaries.
+func isWordBoundary(src []byte, i int) bool {
+	if i < 0 || i >= len(src) {
+		return true
+	}
+	c := src[i]
+	return !(c == '_' || '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z')
+}
+
+// allStyled reports whether every byte of styles is idx.
+func allStyled(styles []byte, idx byte) bool {
+	for _, b := range styles {
+		if b != idx {
+			return false
+		}
+	}
+	return true
+}
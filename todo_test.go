@@ -0,0 +1,26 @@
+package treesitter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMarkTodos(t *testing.T) {
+	src := []byte("x := \"TODO\" // TODO: fix; TODOS and NOTE\n")
+	stylePerByte := make([]byte, len(src))
+	applyCapture(stylePerByte, 5, 11, lookupCaptureIdx("string"))
+	applyCapture(stylePerByte, 12, len(src)-1, lookupCaptureIdx("comment"))
+
+	markTodos(stylePerByte, src, [][]byte{[]byte("TODO"), []byte("NOTE")})
+
+	var marked []string
+	for _, e := range compressToEntries(stylePerByte, src, byteCoords) {
+		if e.Name == CaptureStyle("comment.todo") {
+			marked = append(marked, string(src[e.Start:e.End]))
+		}
+	}
+	// The string and the TODO inside TODOS are left alone.
+	if want := []string{"TODO", "NOTE"}; !reflect.DeepEqual(marked, want) {
+		t.Errorf("marked %q, want %q", marked, want)
+	}
+}
@@ -5,17 +5,33 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"math/rand"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"9fans.net/go/acme"
 	"github.com/cptaffe/acme-styles/layer"
+	"github.com/cptaffe/acme-treesitter/config"
 	"github.com/cptaffe/acme-treesitter/logger"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
 	"go.uber.org/zap"
 )
 
-const layerName = "treesitter"
+// LayerName is the name of the acme-styles layer each window's highlights
+// are drawn in.
+const LayerName = "treesitter"
 
-const debounceDuration = 200 * time.Millisecond
+// A burst of at least pasteBurstEdits edits within one debounce period (a
+// large paste, say) keeps pushing the re-highlight back until the edits
+// settle, but never further than maxDebounceExtension past the first edit.
+const (
+	pasteBurstEdits      = 8
+	maxDebounceExtension = 2 * time.Second
+)
 
 // errWindowClosed is returned by runWindowOnce when the window's edit log
 // reaches EOF cleanly — i.e. the user closed the window.
@@ -30,20 +46,33 @@ const maxRetries = 8
 // via runWindowOnce.  Transient errors (e.g. acme-styles not yet aware of
 // the window) are retried with exponential backoff.  It exits when the
 // window is closed, the context is cancelled, or retries are exhausted.
-func RunWindow(ctx context.Context, id int, name string, handlers []Handler) {
+//
+// A send on reload (the acme log's "get" op) forces a full re-highlight, for
+// when the body was replaced wholesale from disk.
+func RunWindow(ctx context.Context, id int, name string, handlers []Handler, opts Options, reload <-chan struct{}) {
 	ctx = logger.NewContext(ctx, logger.L(ctx).With(zap.Int("window", id), zap.String("name", name)))
 	log := logger.L(ctx)
 
-	lang := detectLang(ctx, id, name, handlers)
-	if lang == nil {
-		log.Debug("no handler matched")
+	det := detectLang(ctx, id, name, handlers, opts)
+	if det.reason == detectNoHandlerMatch && opts.OnUnmatched == config.UnmatchedLog {
+		log.Debug("unmatched file", zap.String("ext", filepath.Ext(name)))
+	}
+	if det.lang == nil {
+		log.Debug("no language detected", zap.Stringer("reason", det.reason))
 		return
 	}
+	lang := det.lang
 	log.Debug("matched language", zap.String("lang", lang.Name))
+	if opts.Preview != nil && opts.Preview.MatchString(name) {
+		log.Debug("preview window", zap.Int("lines", opts.PreviewLines))
+		opts.OpenOnly = true
+	} else {
+		opts.PreviewLines = 0
+	}
 
-	delay := 100 * time.Millisecond
+	b := Backoff{Base: opts.RetryBase, Cap: opts.RetryCap}
 	for attempt := 0; attempt < maxRetries; attempt++ {
-		err := runWindowOnce(ctx, id, lang)
+		err := runWindowOnce(ctx, id, name, lang, opts, reload)
 		switch {
 		case errors.Is(err, errWindowClosed):
 			log.Debug("window closed")
@@ -51,6 +80,7 @@ func RunWindow(ctx context.Context, id int, name string, handlers []Handler) {
 		case ctx.Err() != nil:
 			return
 		}
+		delay := b.Next()
 		log.Debug("session error, retrying",
 			zap.Error(err), zap.Int("attempt", attempt+1), zap.Duration("in", delay))
 		select {
@@ -58,59 +88,230 @@ func RunWindow(ctx context.Context, id int, name string, handlers []Handler) {
 			return
 		case <-time.After(delay):
 		}
-		if delay < 5*time.Second {
-			delay *= 2
-		}
 	}
 	log.Warn("session failed after retries", zap.Int("attempts", maxRetries))
 }
 
+// detectReason explains the outcome of detectLang.
+type detectReason int
+
+const (
+	detectMatched             detectReason = iota
+	detectNoHandlerMatch                   // no pattern matched and no shebang recognized
+	detectLanguageUnsupported              // the chosen language has no registered grammar
+	detectWindowGone                       // the window could not be read
+	detectSkippedSpecial                   // a directory or +Errors-style window
+	detectDefaulted                        // nothing matched; using default_language
+)
+
+func (r detectReason) String() string {
+	switch r {
+	case detectMatched:
+		return "matched"
+	case detectNoHandlerMatch:
+		return "no handler match"
+	case detectLanguageUnsupported:
+		return "language unsupported"
+	case detectWindowGone:
+		return "window gone"
+	case detectSkippedSpecial:
+		return "skipped special window"
+	case detectDefaulted:
+		return "default language"
+	}
+	return fmt.Sprintf("detectReason(%d)", int(r))
+}
+
+// detection is the result of detectLang: a Language, or nil and the reason
+// there is none.
+type detection struct {
+	lang   *Language
+	reason detectReason
+}
+
 // detectLang returns the Language for the given window, trying filename
-// patterns first and falling back to shebang detection.  Returns nil if
-// no pattern matches or the window is unavailable.
-func detectLang(ctx context.Context, id int, name string, handlers []Handler) *Language {
-	if lang := detectLanguage(handlers, name); lang != nil {
-		return lang
+// patterns first and falling back to shebang detection, which looks only at
+// the first opts.DetectBytes bytes of the body.  A shell shebang gives way
+// to a polyglot "exec" line when opts.FollowExec is set.  If neither
+// matches, an extensionless file may still be sniffed as shell
+// (opts.SniffShell), and failing that, if opts.OnUnmatched is "default",
+// opts.DefaultLanguage is used.  Special windows (directories, +Errors and
+// the like) are never highlighted unless opts.Language, which overrides
+// detection altogether, is set.
+func detectLang(ctx context.Context, id int, name string, handlers []Handler, opts Options) detection {
+	if opts.Language != "" {
+		if lang := langByID(opts.Language); lang != nil {
+			return detection{lang: lang}
+		}
+		return detection{reason: detectLanguageUnsupported}
+	}
+	if isSpecialWindow(name) {
+		return detection{reason: detectSkippedSpecial}
+	}
+	lang, matched := detectLanguage(handlers, name)
+	if lang != nil {
+		return detection{lang: lang}
 	}
 	// Shebang fallback — need an acme connection.
 	w, err := acme.Open(id, nil)
 	if err != nil {
-		return nil
+		return detection{reason: detectWindowGone}
 	}
-	body, err := w.ReadBody()
-	w.CloseFiles()
+	defer w.CloseFiles()
+	head, err := readPrefix(w, opts.DetectBytes)
 	if err != nil {
-		return nil
+		return detection{reason: detectWindowGone}
+	}
+	line := firstLine(head)
+	if lang := detectByShebang(line); lang != nil {
+		if opts.FollowExec && lang.Name == "bash" {
+			if l := langByID(langIDForInterpreter(execInterpreter(head))); l != nil {
+				return detection{lang: l}
+			}
+		}
+		return detection{lang: lang}
+	}
+	if matched || langIDForInterpreter(shebanInterpreter(line)) != "" {
+		return detection{reason: detectLanguageUnsupported}
+	}
+	if opts.SniffShell && filepath.Ext(name) == "" && looksLikeShell(head) {
+		if lang := langByID("bash"); lang != nil {
+			return detection{lang: lang}
+		}
+	}
+	if opts.OnUnmatched == config.UnmatchedDefault {
+		if lang := langByID(opts.DefaultLanguage); lang != nil {
+			return detection{lang: lang, reason: detectDefaulted}
+		}
+		return detection{reason: detectLanguageUnsupported}
+	}
+	return detection{reason: detectNoHandlerMatch}
+}
+
+// isSpecialWindow reports whether name belongs to a window that never holds
+// source code: a directory listing, or an acme-generated window such as
+// +Errors or a win(1) "-host" window.
+func isSpecialWindow(name string) bool {
+	if name == "" || strings.HasSuffix(name, "/") {
+		return true
+	}
+	base := filepath.Base(name)
+	return strings.HasPrefix(base, "+") || strings.HasPrefix(base, "-")
+}
+
+// bodyReader is the part of *acme.Win used to read a window body.  Tests
+// substitute an in-memory fake.
+type bodyReader interface {
+	ReadBody() ([]byte, error)
+	prefixReader
+	Seek(file string, offset int64, whence int) (int64, error)
+}
+
+// readBodyLimited reads w's body like ReadBody, except that once more than
+// limit bytes have been read it stops and returns just those: enough for
+// the caller to see the body is over the limit, without holding all of a
+// huge one in memory.
+func readBodyLimited(w bodyReader, limit int) ([]byte, error) {
+	if _, err := w.Seek("body", 0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	var body []byte
+	chunk := make([]byte, 8192)
+	for len(body) <= limit {
+		n, err := w.Read("body", chunk)
+		body = append(body, chunk[:n]...)
+		if err == io.EOF || (err == nil && n == 0) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(body) > limit+1 {
+		body = body[:limit+1]
+	}
+	return body, nil
+}
+
+// prefixReader is the part of *acme.Win used to read the start of a
+// window file.  Tests substitute an in-memory fake.
+type prefixReader interface {
+	Read(file string, b []byte) (int, error)
+}
+
+// readFirstLine returns the first line of w's body (see firstLine), reading
+// at most limit bytes so a huge first line cannot stall detection.  A line
+// longer than limit is returned truncated.
+func readFirstLine(w prefixReader, limit int) (string, error) {
+	head, err := readPrefix(w, limit)
+	if err != nil {
+		return "", err
+	}
+	return firstLine(head), nil
+}
+
+// readPrefix returns up to the first limit bytes of w's body.
+func readPrefix(w prefixReader, limit int) ([]byte, error) {
+	buf := make([]byte, limit)
+	n := 0
+	for n < len(buf) {
+		m, err := w.Read("body", buf[n:])
+		n += m
+		if err == io.EOF || (err == nil && m == 0) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
 	}
-	return detectByShebang(firstLine(body))
+	return buf[:n], nil
 }
 
 // firstLine returns the content of body up to (but not including) the first
-// newline, or the whole body if there is no newline.
+// newline, or the whole body if there is no newline.  A CR before the
+// newline is dropped as well.
 func firstLine(body []byte) string {
 	if i := bytes.IndexByte(body, '\n'); i >= 0 {
-		return string(body[:i])
+		body = bytes.TrimSuffix(body[:i], []byte("\r"))
 	}
 	return string(body)
 }
 
+// linePrefix returns the first n lines of body, newlines included.
+func linePrefix(body []byte, n int) []byte {
+	off := 0
+	for ; n > 0; n-- {
+		i := bytes.IndexByte(body[off:], '\n')
+		if i < 0 {
+			return body
+		}
+		off += i + 1
+	}
+	return body[:off]
+}
+
 // runWindowOnce performs one complete highlight session for a window:
 //   - opens an acme-styles compositor layer,
 //   - opens the window via the shared acme connection,
 //   - does an initial parse + highlight,
-//   - watches the per-window edit log and re-highlights after edits.
+//   - watches the per-window edit log and re-highlights after edits,
+//     unless opts.OpenOnly is set (see watchReloads).
 //
 // It returns errWindowClosed on clean log EOF, ctx.Err() if the context is
 // cancelled, or another error for transient failures the caller should retry.
-func runWindowOnce(ctx context.Context, id int, lang *Language) error {
+func runWindowOnce(ctx context.Context, id int, name string, lang *Language, opts Options, reload <-chan struct{}) error {
 	log := logger.L(ctx)
 
-	sl, err := layer.Open(id, layerName)
+	sl, err := layer.Open(id, LayerName)
 	if err != nil {
 		return fmt.Errorf("open layer: %w", err)
 	}
 	log.Debug("allocated layer", zap.Int("layerID", sl.LayerID))
-	defer sl.Delete()
+	if opts.KeepLayers {
+		log.Debug("keeping layer after the session ends")
+	} else {
+		defer sl.Delete()
+	}
 
 	// acme.Open uses the package-level shared connection (defaultFsys), so all
 	// window goroutines share a single OS-level socket to acme rather than
@@ -120,15 +321,45 @@ func runWindowOnce(ctx context.Context, id int, lang *Language) error {
 		return fmt.Errorf("open acme win: %w", err)
 	}
 
-	if err := doHighlight(ctx, lang, sl, w); err != nil {
+	s := &session{id: id, name: name, lang: lang, opts: opts, sl: sl, w: w,
+		tweaks: newTweaks(opts, name), dot: -1}
+	if opts.ReopenCache > 0 {
+		defer s.remember()
+	}
+	if opts.FoldDir != "" {
+		defer removeFolds(opts.FoldDir, id)
+	}
+	defer s.dropTree()
+
+	if err := doHighlight(ctx, s); err != nil {
 		w.CloseFiles()
 		return fmt.Errorf("initial highlight: %w", err)
 	}
 	log.Debug("initial highlight ok")
 
-	timer := time.NewTimer(debounceDuration)
+	resync := newResyncTimer(opts.Resync)
+	defer resync.stop()
+
+	var dotPoll <-chan time.Time // nil unless bracket matching is on
+	if opts.MatchBrackets > 0 {
+		tick := time.NewTicker(opts.MatchBrackets)
+		defer tick.Stop()
+		dotPoll = tick.C
+	}
+
+	if opts.OpenOnly {
+		return watchReloads(ctx, s, w, reload, resync)
+	}
+
+	debounce := opts.debounce(s.lang)
+	timer := time.NewTimer(debounce)
 	timer.Stop()
 	pending := false
+	var burstStart time.Time // first edit since the last highlight
+	// burstGen is s.edits as of the end of the last burst: the edits
+	// counted since make up the current one.  Notifications on lines are
+	// dropped when it is full, so counting them undercounts a big paste.
+	burstGen := s.edits.Load()
 
 	// lines carries edit notifications (I/D events) from the scanner goroutine.
 	// scanResult carries the exit reason: nil = clean EOF (window closed), else error.
@@ -146,6 +377,8 @@ func runWindowOnce(ctx context.Context, id int, lang *Language) error {
 				return
 			}
 			if e.Op == 'I' || e.Op == 'D' {
+				s.pending.add(bodyEdit{insert: e.Op == 'I', q0: e.Q0, q1: e.Q1, text: e.Text})
+				s.edits.Add(1)
 				select {
 				case lines <- struct{}{}:
 				default:
@@ -155,6 +388,9 @@ func runWindowOnce(ctx context.Context, id int, lang *Language) error {
 	}()
 
 	defer func() {
+		if opts.TagTiming {
+			setTagNote(w, "") //nolint:errcheck // window may already be gone
+		}
 		w.CloseFiles()    // closes the log fid, unblocking ReadLog in the goroutine
 		<-goroutineExited // wait for it to finish
 	}()
@@ -166,8 +402,41 @@ func runWindowOnce(ctx context.Context, id int, lang *Language) error {
 
 		case <-lines:
 			if !pending {
-				timer.Reset(debounceDuration)
+				timer.Reset(debounce)
 				pending = true
+				burstStart = time.Now()
+			}
+			burstEdits := s.edits.Load() - burstGen
+			if burstEdits >= pasteBurstEdits && time.Since(burstStart) < maxDebounceExtension {
+				// Still growing: wait for the edits to settle.
+				timer.Reset(debounce)
+			}
+			if opts.DebounceEdits > 0 && burstEdits >= uint64(opts.DebounceEdits) {
+				timer.Stop()
+				pending = false
+				burstGen = s.edits.Load()
+				if err := doHighlight(ctx, s); err != nil {
+					return fmt.Errorf("re-highlight: %w", err)
+				}
+			}
+
+		case <-reload:
+			timer.Stop()
+			pending = false
+			burstGen = s.edits.Load()
+			log.Debug("reloaded from disk; full re-highlight")
+			if err := s.reload(ctx); err != nil {
+				return fmt.Errorf("reload highlight: %w", err)
+			}
+
+		case <-resync.c():
+			timer.Stop()
+			pending = false
+			burstGen = s.edits.Load()
+			resync.reset()
+			log.Debug("periodic resync; full re-highlight")
+			if err := s.reload(ctx); err != nil {
+				return fmt.Errorf("resync highlight: %w", err)
 			}
 
 		case err := <-scanResult:
@@ -178,23 +447,421 @@ func runWindowOnce(ctx context.Context, id int, lang *Language) error {
 
 		case <-timer.C:
 			pending = false
-			if err := doHighlight(ctx, lang, sl, w); err != nil {
+			burstGen = s.edits.Load()
+			if err := doHighlight(ctx, s); err != nil {
 				return fmt.Errorf("re-highlight: %w", err)
 			}
+
+		case <-dotPoll:
+			if err := s.matchDot(); err != nil {
+				return fmt.Errorf("match brackets: %w", err)
+			}
+		}
+	}
+}
+
+// watchReloads is the open-only counterpart of the edit loop: the window's
+// event log is never read, so edits go unnoticed, but a Get still
+// re-highlights.  It returns when ctx is cancelled, which the tracker does
+// once the window is gone.
+func watchReloads(ctx context.Context, s *session, w *acme.Win, reload <-chan struct{}, resync *resyncTimer) error {
+	defer func() {
+		if s.opts.TagTiming {
+			setTagNote(w, "") //nolint:errcheck // window may already be gone
+		}
+		w.CloseFiles()
+	}()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-reload:
+			logger.L(ctx).Debug("reloaded from disk; full re-highlight")
+			if err := s.reload(ctx); err != nil {
+				return fmt.Errorf("reload highlight: %w", err)
+			}
+		case <-resync.c():
+			resync.reset()
+			logger.L(ctx).Debug("periodic resync; full re-highlight")
+			if err := s.reload(ctx); err != nil {
+				return fmt.Errorf("resync highlight: %w", err)
+			}
 		}
 	}
 }
 
+// resyncTimer fires about every interval, jittered by ±25% so windows
+// opened together do not all re-read their bodies at the same moment.
+// A nil *resyncTimer (interval 0) never fires.
+type resyncTimer struct {
+	interval time.Duration
+	t        *time.Timer
+}
+
+func newResyncTimer(interval time.Duration) *resyncTimer {
+	if interval <= 0 {
+		return nil
+	}
+	return &resyncTimer{interval: interval, t: time.NewTimer(jitter(interval))}
+}
+
+// c returns the channel the timer fires on, or nil (blocking forever) for
+// a disabled timer.
+func (r *resyncTimer) c() <-chan time.Time {
+	if r == nil {
+		return nil
+	}
+	return r.t.C
+}
+
+// reset re-arms the timer after it has fired.
+func (r *resyncTimer) reset() {
+	if r != nil {
+		r.t.Reset(jitter(r.interval))
+	}
+}
+
+func (r *resyncTimer) stop() {
+	if r != nil {
+		r.t.Stop()
+	}
+}
+
+// jitter returns a random duration in [3d/4, 5d/4].
+func jitter(d time.Duration) time.Duration {
+	return d*3/4 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// acmeWin is the part of *acme.Win a session uses.  Tests substitute an
+// in-memory fake.
+type acmeWin interface {
+	bodyReader
+	ReadAll(file string) ([]byte, error)
+	ReadAddr() (q0, q1 int, err error)
+	Ctl(format string, args ...interface{}) error
+	Write(file string, b []byte) (int, error)
+}
+
+// styleLayer is the part of *layer.StyleLayer a session writes to.
+type styleLayer interface {
+	Apply(entries []layer.Entry) error
+}
+
+// session is the state of one runWindowOnce highlight session.
+type session struct {
+	id   int
+	name string
+	lang *Language
+	opts Options
+	sl   styleLayer
+	w    acmeWin
+
+	tweaks tweaks // from opts, for this window
+
+	oversize bool // body exceeded the size limit at the last highlight
+
+	// edits counts the I/D events seen so far.  The log scanner goroutine
+	// bumps it; doHighlight compares it across a highlight to spot edits
+	// that landed after the body was read.
+	edits atomic.Uint64
+
+	// Incremental parsing: the tree of the last full highlight, the body
+	// it was parsed from and its styles (nil if they are incomplete), and
+	// the edits the log scanner has seen since.
+	tree    *tree_sitter.Tree
+	treeSrc []byte
+	styles  []byte
+	pending editLog
+
+	// Most recently applied highlight; valid once highlighted is set.
+	highlighted bool
+	sum         uint64 // bodySum of the highlighted body
+	entries     []layer.Entry
+
+	// Bracket matching, when opts.MatchBrackets is set: the body the
+	// entries came from, the dot last seen (-1 to force a fresh look),
+	// the pair shown, and what was last written: entries with the pair
+	// laid over them.
+	body     []byte
+	dot      int
+	brackets []layer.Entry
+	written  []layer.Entry
+	addrOpen bool
+}
+
+// remember stores the session's last highlight in reopenCache.
+func (s *session) remember() {
+	if !s.highlighted {
+		return
+	}
+	reopenCache.put(s.name, cachedHighlight{
+		lang:    s.lang,
+		sum:     s.sum,
+		entries: s.entries,
+		stored:  time.Now(),
+	}, s.opts.ReopenCache)
+}
+
 // doHighlight reads the window body, parses it with tree-sitter, and writes
-// the resulting highlight entries to sl.
-func doHighlight(ctx context.Context, lang *Language, sl *layer.StyleLayer, w *acme.Win) error {
+// the resulting highlight entries to the session's layer.  Parsing is
+// incremental when the edits logged since the last highlight account for
+// the new body, and from scratch otherwise.  A body the same as the last
+// one highlighted is left as it is.  The initial highlight reuses a
+// reopenCache entry when the file was closed recently with the same
+// contents.
+func doHighlight(ctx context.Context, s *session) error {
 	log := logger.L(ctx)
-	// ReadBody opens a fresh fid each time so reading always starts at offset 0.
-	body, err := w.ReadBody()
+	gen := s.edits.Load()
+	limit := s.opts.maxBytes(s.lang)
+	var body []byte
+	var err error
+	if limit > 0 && !s.opts.LiteOversize {
+		// An oversized body is thrown away, so stop reading it as soon
+		// as it is known to be too big.
+		body, err = readBodyLimited(s.w, limit)
+	} else {
+		// ReadBody opens a fresh fid each time so reading always starts
+		// at offset 0.
+		body, err = s.w.ReadBody()
+	}
 	if err != nil {
 		return err
 	}
-	entries := computeHighlights(lang, body)
-	log.Debug("highlight entries computed", zap.Int("count", len(entries)))
-	return sl.Apply(entries)
+	if s.opts.PreviewLines > 0 {
+		// Entries stop where the prefix does, so applying them clears
+		// anything styled beyond it.
+		body = linePrefix(body, s.opts.PreviewLines)
+	}
+	sum := bodySum(body)
+	if s.highlighted && sum == s.sum {
+		// Edits that cancel out, or text deleted and put back: the last
+		// highlight stands.  The edits are dropped with it, as they add
+		// up to nothing.
+		s.pending.take()
+		log.Debug("body unchanged; not highlighting")
+		return nil
+	}
+	if limit > 0 && len(body) > limit {
+		if !s.oversize {
+			log.Debug("body too large; not highlighting in full",
+				zap.Int("limit", limit), zap.Bool("lite", s.opts.LiteOversize))
+			s.oversize = true
+		}
+		s.dropTree()
+		s.body = nil // matchDot leaves oversized bodies alone
+		if !s.opts.LiteOversize {
+			return s.apply(sum, nil)
+		}
+		entries, _ := computeLiteHighlights(s.lang, body, s.tweaks, s.opts.QueryTimeout)
+		if s.stale(gen) {
+			log.Debug("body edited during highlight; waiting for the next one")
+			return nil
+		}
+		return s.apply(sum, entries)
+	}
+	s.oversize = false
+	if s.opts.MatchBrackets > 0 {
+		s.body = body
+	}
+	if s.opts.FoldDir != "" {
+		if err := writeFolds(s.opts.FoldDir, s.id, computeFolds(s.lang, body)); err != nil {
+			log.Debug("write folds", zap.Error(err))
+		}
+	}
+	if !s.highlighted && s.opts.ReopenCache > 0 {
+		if entries, ok := reopenCache.take(s.name, s.lang, sum, s.opts.ReopenCache, time.Now()); ok {
+			log.Debug("reusing highlight from recent close", zap.Int("count", len(entries)))
+			return s.apply(sum, entries)
+		}
+	}
+	start := time.Now()
+	entries, truncated := s.highlight(ctx, body)
+	elapsed := time.Since(start)
+	if truncated {
+		log.Info("highlight truncated by query timeout",
+			zap.Duration("timeout", s.opts.QueryTimeout), zap.Int("count", len(entries)))
+	}
+	log.Debug("highlight entries computed",
+		zap.Int("count", len(entries)), zap.Duration("elapsed", elapsed))
+	if !s.highlighted {
+		log.Debug("initial highlight styles", zap.String("styles", styleSummary(entries)))
+	}
+	if s.opts.TagTiming {
+		note := fmt.Sprintf("[ts:%s %v]", s.lang.Name, elapsed.Round(time.Millisecond))
+		if err := setTagNote(s.w, note); err != nil {
+			log.Debug("tag timing", zap.Error(err))
+		}
+	}
+	if s.stale(gen) {
+		// The entries' offsets may no longer line up with the body.  The
+		// edits that changed it are already queued for the edit loop,
+		// which will highlight again once they settle.
+		log.Debug("body edited during highlight; waiting for the next one")
+		return nil
+	}
+	return s.apply(sum, entries)
+}
+
+// highlight computes the entries for body.  If the edits logged since the
+// last highlight turn its body into this one, the old tree is reparsed
+// incrementally and, when the edits are local, only the top-level nodes
+// around them are restyled; the rest keeps its old styles.  Otherwise body
+// is parsed and styled from scratch.  The tree and styles are kept for
+// next time.
+func (s *session) highlight(ctx context.Context, body []byte) (entries []layer.Entry, truncated bool) {
+	log := logger.L(ctx)
+	edits, ok := s.pending.take()
+	var old *tree_sitter.Tree
+	var ies []tree_sitter.InputEdit
+	if s.tree != nil {
+		var src []byte
+		var fits bool
+		if !ok {
+			log.Debug("too many edits to replay; full reparse")
+		} else if src, ies, fits = replayEdits(s.tree, s.treeSrc, edits); fits && bytes.Equal(src, body) {
+			old = s.tree
+		} else {
+			// Edits still in flight, a Get, or a log we misread: the
+			// body cannot be trusted to line up with the old tree.
+			log.Debug("logged edits do not match the body; full reparse")
+		}
+	}
+	tree := parse(s.lang, body, old)
+
+	var styles []byte
+	if old != nil && s.styles != nil {
+		styles = editStyles(s.styles, ies)
+		lo, hi := dirtyRange(ies)
+		for _, r := range old.ChangedRanges(tree) {
+			if lo > hi {
+				lo, hi = int(r.StartByte), int(r.EndByte)
+			} else {
+				lo, hi = min(lo, int(r.StartByte)), max(hi, int(r.EndByte))
+			}
+		}
+		if lo <= hi {
+			lo, hi = scopeRange(tree, lo, hi)
+			if (hi-lo)*maxScopedFraction > len(body) {
+				styles = nil
+			} else {
+				clear(styles[lo:hi])
+				truncated = claimCaptures(s.lang.query, tree, body, s.tweaks, s.opts.QueryTimeout, styles, lo, hi)
+				if !truncated {
+					truncated = claimInjections(s.lang, tree, body, s.tweaks, s.opts.QueryTimeout, styles, lo, hi)
+				}
+			}
+		}
+	}
+	if styles == nil {
+		styles, truncated = styleTree(s.lang.query, tree, body, s.tweaks, s.opts.QueryTimeout)
+		if !truncated {
+			truncated = claimInjections(s.lang, tree, body, s.tweaks, s.opts.QueryTimeout, styles, 0, len(body))
+		}
+	}
+	entries = styleEntries(styles, body, s.tweaks)
+
+	s.dropTree()
+	s.tree, s.treeSrc = tree, body
+	if !truncated {
+		// A truncated styling is missing captures and cannot be patched,
+		// so it stays nil.
+		s.styles = styles
+	}
+	return entries, truncated
+}
+
+// dropTree discards the tree and styles kept for incremental parsing, so
+// the next highlight parses and styles from scratch.
+func (s *session) dropTree() {
+	if s.tree != nil {
+		s.tree.Close()
+	}
+	s.tree, s.treeSrc, s.styles = nil, nil, nil
+}
+
+// stale reports whether edits have arrived since s.edits read gen.  The
+// first highlight is never stale: an outdated highlight beats none.
+func (s *session) stale(gen uint64) bool {
+	return s.highlighted && s.edits.Load() != gen
+}
+
+// reload discards any state carried over from earlier highlights and
+// re-highlights the whole body.  Used after a Get replaced the body.
+func (s *session) reload(ctx context.Context) error {
+	s.dropTree()
+	s.highlighted = false
+	s.sum = 0
+	s.entries = nil
+	return doHighlight(ctx, s)
+}
+
+// apply writes entries to the layer and records them as the session's
+// latest highlight.  Entries identical to those already applied are not
+// written again: the layer has no partial update, so the only delta that
+// can be sent cheaply is none at all, which is the common case for edits
+// inside comments and strings that do not move later text.
+//
+// A bracket pair shown by matchDot may no longer be where it was, so it is
+// dropped here and found again on the next poll.
+func (s *session) apply(sum uint64, entries []layer.Entry) error {
+	s.brackets, s.dot = nil, -1
+	if s.highlighted && slices.Equal(entries, s.written) {
+		s.sum = sum
+		s.entries = entries
+		return nil
+	}
+	if err := s.sl.Apply(entries); err != nil {
+		return err
+	}
+	s.highlighted = true
+	s.sum = sum
+	s.entries = entries
+	s.written = entries
+	return nil
+}
+
+// matchDot reads the window's dot and, if it has moved, shows the bracket
+// pair at it, if any.  It is polled every opts.MatchBrackets, as acme
+// reports no cursor movement.  Bodies over the size limit are skipped, as
+// pairing brackets would mean parsing them.
+func (s *session) matchDot() error {
+	if !s.highlighted || s.oversize {
+		return nil
+	}
+	if !s.addrOpen {
+		// Opening the addr file resets addr, so open it before setting it.
+		if _, _, err := s.w.ReadAddr(); err != nil {
+			return err
+		}
+		s.addrOpen = true
+	}
+	if err := s.w.Ctl("addr=dot"); err != nil {
+		return err
+	}
+	q0, _, err := s.w.ReadAddr()
+	if err != nil {
+		return err
+	}
+	if q0 == s.dot {
+		return nil
+	}
+	s.dot = q0
+	var tree *tree_sitter.Tree
+	if s.tree != nil && bytes.Equal(s.treeSrc, s.body) {
+		tree = s.tree // the highlight's own, so no parse per cursor move
+	}
+	var brackets []layer.Entry
+	if a, b, ok := matchBracket(s.lang, tree, s.body, runeToByte(s.body, q0)); ok {
+		brackets = bracketEntries(s.body, a, b)
+	}
+	if slices.Equal(brackets, s.brackets) {
+		return nil
+	}
+	out := overlay(s.entries, brackets)
+	if err := s.sl.Apply(out); err != nil {
+		return err
+	}
+	s.brackets = brackets
+	s.written = out
+	return nil
 }
@@ -0,0 +1,403 @@
+package treesitter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cptaffe/acme-styles/layer"
+	"github.com/cptaffe/acme-treesitter/config"
+)
+
+// fakeWin serves a canned window body in place of acme's <id>/body file.
+type fakeWin struct {
+	body string
+	err  error
+	off  int // offset of the next Read
+
+	onRead func() // if set, called by ReadBody, as if edits raced the read
+	dot    int    // rune offset ReadAddr reports
+}
+
+func (f *fakeWin) Read(file string, b []byte) (int, error) {
+	if f.err != nil {
+		return 0, f.err
+	}
+	if f.off >= len(f.body) {
+		return 0, io.EOF
+	}
+	// Serve short reads, as 9P does for large requests.
+	if len(b) > 100 {
+		b = b[:100]
+	}
+	n := copy(b, f.body[f.off:])
+	f.off += n
+	return n, nil
+}
+
+func (f *fakeWin) Seek(file string, offset int64, whence int) (int64, error) {
+	f.off = int(offset) // only io.SeekStart is used
+	return offset, nil
+}
+
+func (f *fakeWin) ReadBody() ([]byte, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if f.onRead != nil {
+		f.onRead()
+	}
+	return []byte(f.body), nil
+}
+
+func (f *fakeWin) ReadAll(file string) ([]byte, error) {
+	return nil, errors.New("fakeWin: ReadAll not supported")
+}
+
+func (f *fakeWin) ReadAddr() (q0, q1 int, err error) { return f.dot, f.dot, nil }
+
+func (f *fakeWin) Ctl(format string, args ...interface{}) error { return nil }
+
+func (f *fakeWin) Write(file string, b []byte) (int, error) { return len(b), nil }
+
+// fakeLayer records the entries most recently applied to it.
+type fakeLayer struct {
+	entries []layer.Entry
+	applies int
+}
+
+func (f *fakeLayer) Apply(entries []layer.Entry) error {
+	f.entries = entries
+	f.applies++
+	return nil
+}
+
+func TestReadFirstLine(t *testing.T) {
+	long := "#!/bin/sh " + strings.Repeat("x", 1<<20)
+	const limit = 1024
+	cases := []struct {
+		body string
+		want string
+	}{
+		{"#!/usr/bin/env python3\nprint(1)\n", "#!/usr/bin/env python3"},
+		{"#!/bin/sh\r\necho hi\r\n", "#!/bin/sh"},
+		{"no newline", "no newline"},
+		{"", ""},
+		{"\nsecond", ""},
+		{long + "\nnext", long[:limit]},
+		{"#!/bin/sh\r", "#!/bin/sh\r"}, // no newline within the limit
+	}
+	for _, c := range cases {
+		got, err := readFirstLine(&fakeWin{body: c.body}, limit)
+		if err != nil {
+			t.Fatalf("readFirstLine: %v", err)
+		}
+		if got != c.want {
+			t.Errorf("readFirstLine(%.20q) = %.20q (len %d), want %.20q (len %d)",
+				c.body, got, len(got), c.want, len(c.want))
+		}
+	}
+}
+
+func TestReadFirstLineError(t *testing.T) {
+	want := errors.New("window gone")
+	if _, err := readFirstLine(&fakeWin{err: want}, 1024); !errors.Is(err, want) {
+		t.Errorf("readFirstLine error = %v, want %v", err, want)
+	}
+}
+
+func TestDetectLangWithoutAcme(t *testing.T) {
+	handlers, err := CompileHandlers(&config.Config{FilenameHandlers: []config.FilenameHandler{
+		{Pattern: `\.go$`, LanguageID: config.LanguageIDs{"go"}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		name   string
+		lang   string
+		reason detectReason
+	}{
+		{"/src/main.go", "go", detectMatched},
+		{"/src/", "", detectSkippedSpecial},
+		{"/src/+Errors", "", detectSkippedSpecial},
+		{"/src/-myhost", "", detectSkippedSpecial},
+		{"", "", detectSkippedSpecial},
+	}
+	for _, c := range cases {
+		det := detectLang(context.Background(), 1, c.name, handlers, Options{DetectBytes: 1024})
+		got := ""
+		if det.lang != nil {
+			got = det.lang.Name
+		}
+		if got != c.lang || det.reason != c.reason {
+			t.Errorf("detectLang(%q) = %q, %v; want %q, %v", c.name, got, det.reason, c.lang, c.reason)
+		}
+	}
+}
+
+func TestReloadReplacesBody(t *testing.T) {
+	ctx := context.Background()
+	w := &fakeWin{body: "package a\n\nfunc f() {}\n"}
+	sl := &fakeLayer{}
+	s := &session{name: "/src/a.go", lang: langByID("go"), sl: sl, w: w}
+	if err := doHighlight(ctx, s); err != nil {
+		t.Fatal(err)
+	}
+
+	// Get replaces the whole body at once.
+	w.body = "// Package b.\npackage b\n"
+	if err := s.reload(ctx); err != nil {
+		t.Fatal(err)
+	}
+	want, _ := computeHighlights(langByID("go"), []byte(w.body), tweaks{}, 0)
+	if !reflect.DeepEqual(sl.entries, want) {
+		t.Errorf("after reload applied %+v, want %+v", sl.entries, want)
+	}
+	if sl.applies != 2 {
+		t.Errorf("applies = %d, want 2", sl.applies)
+	}
+}
+
+func TestMaxBytes(t *testing.T) {
+	ctx := context.Background()
+	w := &fakeWin{body: "package a\n\nfunc f() {}\n"}
+	sl := &fakeLayer{}
+	s := &session{
+		name: "/src/a.go",
+		lang: langByID("go"),
+		opts: Options{MaxBytes: map[string]int{"go": 16}},
+		sl:   sl,
+		w:    w,
+	}
+	if err := doHighlight(ctx, s); err != nil {
+		t.Fatal(err)
+	}
+	if sl.applies != 1 || sl.entries != nil {
+		t.Errorf("oversized body: applies = %d, entries = %v; want 1 clearing apply", sl.applies, sl.entries)
+	}
+
+	w.body = "package a\n"
+	if err := doHighlight(ctx, s); err != nil {
+		t.Fatal(err)
+	}
+	if len(sl.entries) == 0 {
+		t.Error("body under the limit was not highlighted")
+	}
+}
+
+func TestMaxFileBytes(t *testing.T) {
+	opts := Options{MaxBytes: map[string]int{"c": 0, "python": 8}, MaxFileBytes: 16}
+	for id, want := range map[string]int{"go": 16, "c": 0, "python": 8} {
+		if got := opts.maxBytes(langByID(id)); got != want {
+			t.Errorf("maxBytes(%s) = %d, want %d", id, got, want)
+		}
+	}
+
+	ctx := context.Background()
+	sl := &fakeLayer{}
+	s := &session{
+		name: "/src/a.go",
+		lang: langByID("go"),
+		opts: Options{MaxFileBytes: 16},
+		sl:   sl,
+		w:    &fakeWin{body: "package a\n\nfunc f() {}\n"},
+	}
+	if err := doHighlight(ctx, s); err != nil {
+		t.Fatal(err)
+	}
+	if sl.applies != 1 || sl.entries != nil {
+		t.Errorf("oversized body: applies = %d, entries = %v; want 1 clearing apply", sl.applies, sl.entries)
+	}
+}
+
+func TestMaxBytesLite(t *testing.T) {
+	ctx := context.Background()
+	w := &fakeWin{body: "// Package a.\npackage a\n\nvar s = \"x\"\n"}
+	sl := &fakeLayer{}
+	s := &session{
+		name: "/src/a.go",
+		lang: langByID("go"),
+		opts: Options{MaxBytes: map[string]int{"go": 16}, LiteOversize: true},
+		sl:   sl,
+		w:    w,
+	}
+	if err := doHighlight(ctx, s); err != nil {
+		t.Fatal(err)
+	}
+	if len(sl.entries) == 0 {
+		t.Fatal("oversized body got no lite highlight")
+	}
+	for _, e := range sl.entries {
+		if e.Name != canonicalTable[lookupCaptureIdx("comment")] && e.Name != canonicalTable[lookupCaptureIdx("string")] {
+			t.Errorf("lite highlight has %+v; want only comments and strings", e)
+		}
+	}
+}
+
+func TestDebounceLanguages(t *testing.T) {
+	opts := Options{
+		Debounce:          200 * time.Millisecond,
+		DebounceLanguages: map[string]time.Duration{"cpp": 500 * time.Millisecond, "bash": 0},
+	}
+	for id, want := range map[string]time.Duration{
+		"cpp":  500 * time.Millisecond,
+		"bash": 200 * time.Millisecond, // zero is unset
+		"go":   200 * time.Millisecond,
+	} {
+		if got := opts.debounce(langByID(id)); got != want {
+			t.Errorf("debounce(%s) = %v, want %v", id, got, want)
+		}
+	}
+}
+
+func TestPreviewLines(t *testing.T) {
+	ctx := context.Background()
+	w := &fakeWin{body: "package a\n\n// Two.\nvar s = \"x\"\n"}
+	sl := &fakeLayer{}
+	s := &session{name: "/src/a.go", lang: langByID("go"), opts: Options{PreviewLines: 2}, sl: sl, w: w}
+	if err := doHighlight(ctx, s); err != nil {
+		t.Fatal(err)
+	}
+	if len(sl.entries) == 0 {
+		t.Fatal("preview got no highlight")
+	}
+	for _, e := range sl.entries {
+		if e.End > len("package a\n\n") {
+			t.Errorf("preview highlight has %+v, beyond the first 2 lines", e)
+		}
+	}
+}
+
+func TestLinePrefix(t *testing.T) {
+	for _, tt := range []struct {
+		body string
+		n    int
+		want string
+	}{
+		{"a\nb\nc\n", 2, "a\nb\n"},
+		{"a\nb\nc", 3, "a\nb\nc"},
+		{"a\nb", 5, "a\nb"},
+		{"", 1, ""},
+	} {
+		if got := string(linePrefix([]byte(tt.body), tt.n)); got != tt.want {
+			t.Errorf("linePrefix(%q, %d) = %q, want %q", tt.body, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestJitter(t *testing.T) {
+	const d = 4 * time.Minute
+	for i := 0; i < 1000; i++ {
+		if j := jitter(d); j < 3*d/4 || j > 5*d/4 {
+			t.Fatalf("jitter(%v) = %v, want within [%v, %v]", d, j, 3*d/4, 5*d/4)
+		}
+	}
+	if r := newResyncTimer(0); r != nil || r.c() != nil {
+		t.Error("newResyncTimer(0) is not a disabled timer")
+	}
+}
+
+func TestStaleHighlightSkipped(t *testing.T) {
+	ctx := context.Background()
+	w := &fakeWin{body: "package a\n"}
+	sl := &fakeLayer{}
+	s := &session{name: "/src/a.go", lang: langByID("go"), sl: sl, w: w}
+	if err := doHighlight(ctx, s); err != nil {
+		t.Fatal(err)
+	}
+
+	// An edit lands while the body is being read.
+	w.body = "package b\n\nfunc f() {}\n"
+	w.onRead = func() { s.edits.Add(1) }
+	if err := doHighlight(ctx, s); err != nil {
+		t.Fatal(err)
+	}
+	if sl.applies != 1 {
+		t.Errorf("applies = %d after a raced read, want 1 (stale result dropped)", sl.applies)
+	}
+
+	w.onRead = nil
+	if err := doHighlight(ctx, s); err != nil {
+		t.Fatal(err)
+	}
+	if sl.applies != 2 {
+		t.Errorf("applies = %d after a quiet read, want 2", sl.applies)
+	}
+}
+
+func TestApplyUnchangedSkipped(t *testing.T) {
+	ctx := context.Background()
+	w := &fakeWin{body: "package a\n\n// x\n"}
+	sl := &fakeLayer{}
+	s := &session{name: "/src/a.go", lang: langByID("go"), sl: sl, w: w}
+	if err := doHighlight(ctx, s); err != nil {
+		t.Fatal(err)
+	}
+
+	// Same length, so every span stays put.
+	w.body = "package a\n\n// y\n"
+	if err := doHighlight(ctx, s); err != nil {
+		t.Fatal(err)
+	}
+	if sl.applies != 1 {
+		t.Errorf("applies = %d after an edit that moved no span, want 1", sl.applies)
+	}
+
+	if err := s.reload(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if sl.applies != 2 {
+		t.Errorf("applies = %d after reload, want 2 (reload always writes)", sl.applies)
+	}
+}
+
+func TestUnchangedBodySkipped(t *testing.T) {
+	ctx := context.Background()
+	w := &fakeWin{body: "package a\n\n// x\n"}
+	sl := &fakeLayer{}
+	s := &session{name: "/src/a.go", lang: langByID("go"), sl: sl, w: w}
+	defer s.dropTree()
+	if err := doHighlight(ctx, s); err != nil {
+		t.Fatal(err)
+	}
+	tree := s.tree
+
+	// Text typed and deleted again: logged, but the body is as it was.
+	s.pending.add(bodyEdit{insert: true, q0: 0, text: "x"})
+	s.pending.add(bodyEdit{q0: 0, q1: 1})
+	if err := doHighlight(ctx, s); err != nil {
+		t.Fatal(err)
+	}
+	if s.tree != tree {
+		t.Error("unchanged body was parsed again")
+	}
+	if edits, _ := s.pending.take(); len(edits) != 0 {
+		t.Errorf("edits %v kept after an unchanged body", edits)
+	}
+	if sl.applies != 1 {
+		t.Errorf("applies = %d, want 1", sl.applies)
+	}
+}
+
+func TestReadBodyLimited(t *testing.T) {
+	w := &fakeWin{body: strings.Repeat("x", 1000), off: 500}
+	got, err := readBodyLimited(w, 2000)
+	if err != nil || string(got) != w.body {
+		t.Errorf("under the limit: got %d bytes, %v; want the whole 1000-byte body", len(got), err)
+	}
+
+	w.off = 0
+	w.body = strings.Repeat("x", 1<<20)
+	got, err = readBodyLimited(w, 250)
+	if err != nil || len(got) != 251 {
+		t.Errorf("over the limit: got %d bytes, %v; want 251", len(got), err)
+	}
+	if w.off > 300 {
+		t.Errorf("read %d bytes of an oversized body, want to stop just past the 250-byte limit", w.off)
+	}
+}